@@ -0,0 +1,223 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+)
+
+// exitCodeFindings is the exit code when the audit finds risks at or above --fail-on,
+// so that pipelines can tell findings apart from failures to run the audit
+const exitCodeFindings = 2
+
+// auditCategories are the risk categories the audit supports
+var auditCategories = []string{
+	string(n8n.Credentials),
+	string(n8n.Database),
+	string(n8n.Filesystem),
+	string(n8n.Instance),
+	string(n8n.Nodes),
+}
+
+// AuditCmd represents the audit command
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Generate a security audit of the n8n instance",
+	Long: `Generate a security audit of the n8n instance and print its findings as a
+table, as JSON or as SARIF for code scanning tools.
+
+n8n does not rate its findings, so each one is given a severity from its risk
+report section: unprotected webhooks, outdated instances, community nodes and
+SQL expressions are high, unused credentials and security settings are low and
+everything else is medium. With --fail-on the command exits with code 2 when
+any finding has at least that severity.`,
+	Example: `  n8n audit
+  n8n audit --categories credentials,nodes --days-abandoned-workflow 30
+  n8n audit -o sarif --fail-on high > audit.sarif`,
+	Args: cobra.NoArgs,
+	RunE: runAudit,
+}
+
+func init() {
+	rootcmd.GetRootCmd().AddCommand(AuditCmd)
+
+	AuditCmd.Flags().StringSlice("categories", nil, "Risk categories to audit: "+strings.Join(auditCategories, ", ")+" (default all)")
+	AuditCmd.Flags().Int("days-abandoned-workflow", 0, "Days without executions after which a workflow is considered abandoned (default instance setting)")
+	AuditCmd.Flags().String("fail-on", "none", "Exit with code 2 when a finding has at least this severity: low, medium, high or none")
+	AuditCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or sarif")
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	categories, _ := cmd.Flags().GetStringSlice("categories")
+	days, _ := cmd.Flags().GetInt("days-abandoned-workflow")
+	failOnFlag, _ := cmd.Flags().GetString("fail-on")
+	output, _ := cmd.Flags().GetString("output")
+
+	switch output {
+	case formatTable, formatJSON, formatSARIF:
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, sarif", output)
+	}
+	failOn, err := parseSeverity(failOnFlag)
+	if err != nil {
+		return err
+	}
+	for i, category := range categories {
+		categories[i] = strings.ToLower(strings.TrimSpace(category))
+		if !contains(auditCategories, categories[i]) {
+			return fmt.Errorf("invalid category '%s': must be one of %s", category, strings.Join(auditCategories, ", "))
+		}
+	}
+	if days < 0 {
+		return fmt.Errorf("--days-abandoned-workflow must not be negative")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	audit, err := client.GenerateAudit(ctx, categories, days)
+	var findings []finding
+	if err == nil {
+		findings, err = auditFindings(audit)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error generating audit: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	switch output {
+	case formatTable:
+		err = printTable(cmd, findings)
+	case formatJSON:
+		err = printJSON(cmd, findings)
+	case formatSARIF:
+		err = printJSON(cmd, toSARIF(findings))
+	}
+	if err != nil {
+		return err
+	}
+
+	if failOn == severityNone {
+		return nil
+	}
+	failing := 0
+	for _, f := range findings {
+		if f.severity >= failOn {
+			failing++
+		}
+	}
+	if failing > 0 {
+		// The findings are already printed, the usage would only obscure them
+		cmd.SilenceUsage = true
+		return &rootcmd.ExitError{
+			Code: exitCodeFindings,
+			Err:  fmt.Errorf("audit found %d risk(s) with %s severity or higher", failing, failOn),
+		}
+	}
+
+	return nil
+}
+
+// printTable prints one row per finding, followed by the recommendation of each
+// report section and a count of the findings by severity
+func printTable(cmd *cobra.Command, findings []finding) error {
+	out := cmd.OutOrStdout()
+	if len(findings) == 0 {
+		_, err := fmt.Fprintln(out, "No risks found")
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if _, err := fmt.Fprintln(w, "SEVERITY\tCATEGORY\tFINDING\tLOCATION"); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	for _, f := range findings {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", f.Severity, f.Category, f.Title, locationString(f.Location)); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(out, "\nRecommendations:"); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	counts := make(map[severity]int)
+	seen := make(map[string]bool)
+	for _, f := range findings {
+		counts[f.severity]++
+		if seen[f.Title] || f.Recommendation == "" {
+			continue
+		}
+		seen[f.Title] = true
+		if _, err := fmt.Fprintf(out, "  - %s: %s\n", f.Title, f.Recommendation); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	_, err := fmt.Fprintf(out, "\n%d finding(s): %d high, %d medium, %d low\n", len(findings), counts[severityHigh], counts[severityMedium], counts[severityLow])
+	if err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	return nil
+}
+
+// printJSON prints value as indented JSON
+func printJSON(cmd *cobra.Command, value interface{}) error {
+	jsonData, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling audit to JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+	return err
+}
+
+// contains reports whether values contains value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+// Package audit contains the audit command of the n8n-cli.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// severity ranks how risky a finding is. n8n does not rate its findings, so the
+// severity is assigned by the CLI from the category and title of the report section.
+type severity int
+
+const (
+	severityNone severity = iota
+	severityLow
+	severityMedium
+	severityHigh
+)
+
+var severityNames = []string{"none", "low", "medium", "high"}
+
+func (s severity) String() string {
+	return severityNames[s]
+}
+
+// parseSeverity returns the severity with the given name
+func parseSeverity(name string) (severity, error) {
+	for i, severityName := range severityNames {
+		if strings.EqualFold(name, severityName) {
+			return severity(i), nil
+		}
+	}
+
+	return severityNone, fmt.Errorf("invalid severity '%s': must be one of %s", name, strings.Join(severityNames, ", "))
+}
+
+// categorySeverity is the severity of the findings of each category that no
+// entry of sectionSeverity matches
+var categorySeverity = map[string]severity{
+	string(n8n.Credentials): severityLow,
+	string(n8n.Database):    severityHigh,
+	string(n8n.Filesystem):  severityMedium,
+	string(n8n.Instance):    severityMedium,
+	string(n8n.Nodes):       severityMedium,
+}
+
+// sectionSeverity overrides the severity of the report sections whose title contains titlePart
+var sectionSeverity = []struct {
+	category  string
+	titlePart string
+	severity  severity
+}{
+	{category: string(n8n.Instance), titlePart: "unprotected webhooks", severity: severityHigh},
+	{category: string(n8n.Instance), titlePart: "outdated instance", severity: severityHigh},
+	{category: string(n8n.Instance), titlePart: "security settings", severity: severityLow},
+	{category: string(n8n.Nodes), titlePart: "community nodes", severity: severityHigh},
+}
+
+// sectionSeverityOf returns the severity of the findings of a report section
+func sectionSeverityOf(category, title string) severity {
+	lowerTitle := strings.ToLower(title)
+	for _, rule := range sectionSeverity {
+		if rule.category == category && strings.Contains(lowerTitle, rule.titlePart) {
+			return rule.severity
+		}
+	}
+
+	if s, ok := categorySeverity[category]; ok {
+		return s
+	}
+	return severityMedium
+}
+
+// riskReport is one risk report of an audit, such as the Credentials Risk Report
+type riskReport struct {
+	Risk     string        `json:"risk"`
+	Sections []riskSection `json:"sections"`
+}
+
+// riskSection is a kind of risk found by the audit, with the places it was found at
+type riskSection struct {
+	Title          string                   `json:"title"`
+	Description    string                   `json:"description"`
+	Recommendation string                   `json:"recommendation"`
+	Location       []map[string]interface{} `json:"location"`
+}
+
+// finding is a risk found at one location
+type finding struct {
+	Category       string                 `json:"category"`
+	Severity       string                 `json:"severity"`
+	Title          string                 `json:"title"`
+	Description    string                 `json:"description"`
+	Recommendation string                 `json:"recommendation"`
+	Location       map[string]interface{} `json:"location,omitempty"`
+
+	severity severity
+}
+
+// auditFindings flattens the reports of an audit into one finding per location,
+// ordered by descending severity. A section without locations, such as an
+// outdated instance, is a single finding.
+func auditFindings(audit *n8n.Audit) ([]finding, error) {
+	reports := []struct {
+		category string
+		report   *map[string]interface{}
+	}{
+		{category: string(n8n.Credentials), report: audit.CredentialsRiskReport},
+		{category: string(n8n.Database), report: audit.DatabaseRiskReport},
+		{category: string(n8n.Filesystem), report: audit.FilesystemRiskReport},
+		{category: string(n8n.Instance), report: audit.InstanceRiskReport},
+		{category: string(n8n.Nodes), report: audit.NodesRiskReport},
+	}
+
+	findings := []finding{}
+	for _, entry := range reports {
+		if entry.report == nil {
+			continue
+		}
+
+		data, err := json.Marshal(entry.report)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s risk report: %w", entry.category, err)
+		}
+		var report riskReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("error reading %s risk report: %w", entry.category, err)
+		}
+
+		for _, section := range report.Sections {
+			s := sectionSeverityOf(entry.category, section.Title)
+			base := finding{
+				Category:       entry.category,
+				Severity:       s.String(),
+				Title:          section.Title,
+				Description:    section.Description,
+				Recommendation: section.Recommendation,
+				severity:       s,
+			}
+
+			if len(section.Location) == 0 {
+				findings = append(findings, base)
+				continue
+			}
+			for _, location := range section.Location {
+				f := base
+				f.Location = location
+				findings = append(findings, f)
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].severity > findings[j].severity
+	})
+
+	return findings, nil
+}
+
+// locationString describes the location of a finding in a single line
+func locationString(location map[string]interface{}) string {
+	if len(location) == 0 {
+		return "-"
+	}
+
+	get := func(key string) string {
+		if value, ok := location[key]; ok && value != nil {
+			return fmt.Sprint(value)
+		}
+		return ""
+	}
+
+	switch get("kind") {
+	case "credential":
+		return fmt.Sprintf("credential '%s' (ID: %s)", get("name"), get("id"))
+	case "node":
+		return fmt.Sprintf("workflow '%s' (ID: %s), node '%s' (%s)", get("workflowName"), get("workflowId"), get("nodeName"), get("nodeType"))
+	case "community":
+		return fmt.Sprintf("community node %s (%s)", get("nodeType"), get("packageUrl"))
+	case "custom":
+		return fmt.Sprintf("custom node %s (%s)", get("nodeType"), get("filePath"))
+	}
+
+	keys := make([]string, 0, len(location))
+	for key := range location {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, location[key]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// nonAlphanumeric matches the characters replaced in rule IDs
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ruleID returns a stable identifier for the findings of a report section
+func ruleID(category, title string) string {
+	return category + "/" + strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"github.com/edenreich/n8n-cli/config"
+)
+
+// sarifSchema is the JSON schema of the SARIF 2.1.0 format
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	FullDescription      sarifMessage       `json:"fullDescription"`
+	Help                 sarifMessage       `json:"help"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifProperties    `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifProperties struct {
+	Tags             []string `json:"tags"`
+	SecuritySeverity string   `json:"security-severity"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	Kind               string `json:"kind,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// sarifLevel maps a severity to a SARIF result level
+func sarifLevel(s severity) string {
+	switch s {
+	case severityHigh:
+		return "error"
+	case severityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifSecuritySeverity maps a severity to the numeric score code scanning tools
+// such as GitHub use to rank security results
+func sarifSecuritySeverity(s severity) string {
+	switch s {
+	case severityHigh:
+		return "8.0"
+	case severityMedium:
+		return "5.0"
+	default:
+		return "2.0"
+	}
+}
+
+// toSARIF converts the findings to a SARIF log with one rule per report section.
+// Findings have logical locations, as they refer to workflows, nodes and
+// credentials of the instance rather than to files.
+func toSARIF(findings []finding) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "n8n-cli audit",
+			Version:        config.Version,
+			InformationURI: "https://docs.n8n.io/hosting/securing/security-audit/",
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+
+	ruleIndex := make(map[string]bool)
+	for _, f := range findings {
+		id := ruleID(f.Category, f.Title)
+		if !ruleIndex[id] {
+			ruleIndex[id] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:                   id,
+				Name:                 f.Title,
+				ShortDescription:     sarifMessage{Text: f.Title},
+				FullDescription:      sarifMessage{Text: f.Description},
+				Help:                 sarifMessage{Text: f.Recommendation},
+				DefaultConfiguration: sarifConfiguration{Level: sarifLevel(f.severity)},
+				Properties: sarifProperties{
+					Tags:             []string{"security", f.Category},
+					SecuritySeverity: sarifSecuritySeverity(f.severity),
+				},
+			})
+		}
+
+		message := f.Description
+		if message == "" {
+			message = f.Title
+		}
+		result := sarifResult{
+			RuleID:  id,
+			Level:   sarifLevel(f.severity),
+			Message: sarifMessage{Text: message},
+		}
+		if len(f.Location) > 0 {
+			kind, _ := f.Location["kind"].(string)
+			name := locationString(f.Location)
+			result.Locations = []sarifLocation{{LogicalLocations: []sarifLogicalLocation{{
+				Name:               name,
+				Kind:               kind,
+				FullyQualifiedName: f.Category + "/" + name,
+			}}}}
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	return sarifLog{Schema: sarifSchema, Version: "2.1.0", Runs: []sarifRun{run}}
+}
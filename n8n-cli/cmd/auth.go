@@ -0,0 +1,55 @@
+// Package cmd contains commands for the n8n-cli
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/viper"
+)
+
+// Supported values of the auth.type configuration key
+const (
+	authTypeHeader = "header"
+	authTypeBearer = "bearer"
+	authTypeExec   = "exec"
+)
+
+// authenticatorFromConfig builds the authenticator described by the auth
+// section of the configuration. It returns nil when no auth type is set, in
+// which case only the API key is sent.
+//
+//	auth:
+//	  type: exec
+//	  command: gcloud
+//	  args: [auth, print-identity-token]
+func authenticatorFromConfig() (n8n.Authenticator, error) {
+	authType := viper.GetString("auth.type")
+
+	switch authType {
+	case "":
+		return nil, nil
+	case authTypeHeader:
+		headers := viper.GetStringMapString("auth.headers")
+		if len(headers) == 0 {
+			return nil, fmt.Errorf("auth type %s requires auth.headers", authType)
+		}
+		return n8n.HeaderAuth(headers), nil
+	case authTypeBearer:
+		token := viper.GetString("auth.token")
+		if token == "" {
+			return nil, fmt.Errorf("auth type %s requires auth.token", authType)
+		}
+		return n8n.BearerTokenAuth(token), nil
+	case authTypeExec:
+		command := viper.GetString("auth.command")
+		if command == "" {
+			return nil, fmt.Errorf("auth type %s requires auth.command", authType)
+		}
+		auth := n8n.NewExecTokenAuth(command, viper.GetStringSlice("auth.args")...)
+		auth.Header = viper.GetString("auth.header")
+		return auth, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth type %q (expected %s, %s or %s)", authType, authTypeHeader, authTypeBearer, authTypeExec)
+	}
+}
@@ -0,0 +1,89 @@
+// Package cmd contains commands for the n8n-cli
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/edenreich/n8n-cli/logger"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/viper"
+)
+
+// NewAPIClient creates an n8n client from the resolved configuration, including
+// the settings of the active context. Every command talking to the API obtains
+// its client here, so the timeout, retry, logging and user agent settings apply
+// uniformly across the command tree. ctx bounds the api_key_command, so an
+// interrupted command does not wait for it.
+func NewAPIClient(ctx context.Context, opts ...n8n.Option) (*n8n.Client, error) {
+	if err := config.ValidateContext(viper.GetViper()); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := config.ResolveAPIKey(ctx, viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("API key not found in configuration")
+	}
+
+	instanceURL, ok := viper.Get("instance_url").(string)
+	if !ok || instanceURL == "" {
+		return nil, fmt.Errorf("instance URL not found in configuration")
+	}
+
+	policy := n8n.DefaultRetryPolicy()
+	if viper.IsSet("retry_max_attempts") {
+		policy.MaxAttempts = viper.GetInt("retry_max_attempts")
+	}
+	if viper.IsSet("retry_backoff") {
+		policy.BaseDelay = viper.GetDuration("retry_backoff")
+	}
+	if viper.IsSet("retry_max_delay") {
+		policy.MaxDelay = viper.GetDuration("retry_max_delay")
+	}
+	policy.RetryNonIdempotent = viper.GetBool("retry_non_idempotent")
+
+	transportConfig := n8n.TransportConfig{
+		CAFile:             viper.GetString("ca_file"),
+		ClientCert:         viper.GetString("client_cert"),
+		ClientKey:          viper.GetString("client_key"),
+		InsecureSkipVerify: viper.GetBool("insecure_skip_verify"),
+		ProxyURL:           viper.GetString("proxy_url"),
+	}
+
+	defaults := []n8n.Option{
+		n8n.WithLogger(logger.Get().Named("api")),
+		n8n.WithUserAgent(fmt.Sprintf("n8n-cli/%s", config.Version)),
+		n8n.WithTimeout(viper.GetDuration("timeout")),
+		n8n.WithRetryPolicy(policy),
+	}
+
+	auth, err := authenticatorFromConfig()
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		defaults = append(defaults, n8n.WithAuth(auth))
+	}
+
+	if !transportConfig.IsZero() {
+		transport, err := n8n.NewTransport(transportConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if transportConfig.InsecureSkipVerify {
+			fmt.Fprintf(os.Stderr, "WARNING: TLS certificate verification is disabled (insecure_skip_verify). "+
+				"The connection to %s can be intercepted and the API key stolen. Never use this in production.\n", instanceURL)
+		}
+
+		defaults = append(defaults, n8n.WithHTTPClient(&http.Client{Transport: transport}))
+	}
+
+	return n8n.NewClient(instanceURL, apiKey, append(defaults, opts...)...), nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// EncryptEnvCmd represents the encrypt-env command
+var EncryptEnvCmd = &cobra.Command{
+	Use:   "encrypt-env",
+	Short: "Encrypt the values of a .env file",
+	Long: `Encrypt every value of a .env file into the ENC(...) form that the CLI decrypts
+in memory when loading the .env file. Comments, empty values and values that are
+already encrypted are kept as they are.
+
+The passphrase is read from the ENC_KEY environment variable, from the file set by
+enc_key_file (env: N8N_ENC_KEY_FILE), or prompted for.`,
+	Example: `  # Write the encrypted form of .env to .env.enc
+  n8n config encrypt-env -i .env -o .env.enc`,
+	Args: cobra.NoArgs,
+	RunE: encryptEnv,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(EncryptEnvCmd)
+
+	EncryptEnvCmd.Flags().StringP("input", "i", ".env", "The .env file to encrypt")
+	EncryptEnvCmd.Flags().StringP("output", "o", "", "The file to write the encrypted form to (default: stdout)")
+}
+
+func encryptEnv(cmd *cobra.Command, args []string) error {
+	input, _ := cmd.Flags().GetString("input")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && filepath.Clean(output) == filepath.Clean(input) {
+		return fmt.Errorf("input and output files must be different")
+	}
+
+	content, err := os.ReadFile(input)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", input, err)
+	}
+
+	passphrase, err := n8nconfig.ResolvePassphrase(viper.GetViper(), true)
+	if err != nil {
+		return err
+	}
+
+	encrypted, count, err := encryptEnvContent(content, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		if _, err := cmd.OutOrStdout().Write(encrypted); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	} else if err := os.WriteFile(output, encrypted, 0600); err != nil {
+		return fmt.Errorf("error writing %s: %w", output, err)
+	}
+
+	if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Encrypted %d value(s) from %s\n", count, input); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
+
+// encryptEnvContent encrypts the values of a .env file and returns the new content
+// together with the number of encrypted values
+func encryptEnvContent(content []byte, passphrase string) ([]byte, int, error) {
+	var out bytes.Buffer
+	count := 0
+
+	reader := bufio.NewReader(bytes.NewReader(content))
+	for {
+		line, readErr := reader.ReadString('\n')
+		if line == "" && readErr != nil {
+			if readErr == io.EOF {
+				break
+			}
+			return nil, 0, readErr
+		}
+
+		text := strings.TrimRight(line, "\r\n")
+		newline := line[len(text):]
+
+		trimmed := strings.TrimSpace(text)
+		key, value, found := strings.Cut(text, "=")
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || !found || value == "" || n8nconfig.IsEncrypted(value) {
+			out.WriteString(line)
+			continue
+		}
+
+		encrypted, err := n8nconfig.EncryptValue(value, passphrase)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error encrypting %s: %w", strings.TrimSpace(key), err)
+		}
+
+		out.WriteString(key + "=" + encrypted + newline)
+		count++
+	}
+
+	return out.Bytes(), count, nil
+}
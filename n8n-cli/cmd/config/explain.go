@@ -0,0 +1,106 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// ExplainCmd represents the explain command
+var ExplainCmd = &cobra.Command{
+	Use:   "explain KEY",
+	Short: "Show where the value of a configuration key comes from",
+	Long: `Show every layer that defines a configuration key and which one wins.
+Layers are listed from the highest precedence to the lowest:
+
+  1. flag, such as --url
+  2. environment variable, such as N8N_INSTANCE_URL
+  3. .env file in the current directory
+  4. active context of the config file, above the .env file when it is
+     selected with --context or N8N_CONTEXT
+  5. config file, $HOME/.n8n/config.yaml or ./config.yaml (only the first one found is loaded)
+  6. default value of the flag
+
+Secret values are redacted.`,
+	Example: `  n8n config explain instance_url
+  n8n config explain api_key --context prod`,
+	Args: cobra.ExactArgs(1),
+	RunE: explain,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(ExplainCmd)
+
+	ExplainCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func explain(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	layers, err := n8nconfig.Explain(viper.GetViper(), cmd.Flags(), args[0])
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case formatTable:
+		if len(layers) == 0 {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "%s is not set\n", args[0])
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "SOURCE\tORIGIN\tVALUE\tSTATUS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, layer := range layers {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%v\t%s\n", layer.Source, layer.Origin, layer.Value, layer.Status); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(layers, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling layers to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(layers)
+		if err != nil {
+			return fmt.Errorf("error marshaling layers to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// contextSummary describes a context in the get-contexts output
+type contextSummary struct {
+	Name        string `json:"name" yaml:"name"`
+	InstanceURL string `json:"instanceUrl" yaml:"instanceUrl"`
+	Current     bool   `json:"current" yaml:"current"`
+}
+
+// GetContextsCmd represents the get-contexts command
+var GetContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List the contexts defined in the config file",
+	Long:  `List the contexts defined in the config file. The context in use is marked with an asterisk.`,
+	Args:  cobra.NoArgs,
+	RunE:  getContexts,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(GetContextsCmd)
+
+	GetContextsCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func getContexts(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	v := viper.GetViper()
+	contexts := n8nconfig.Contexts(v)
+	active := n8nconfig.ActiveContext(v)
+
+	summaries := make([]contextSummary, 0, len(contexts))
+	for _, name := range n8nconfig.ContextNames(v) {
+		instanceURL, _ := contexts[name]["instance_url"].(string)
+		summaries = append(summaries, contextSummary{
+			Name:        name,
+			InstanceURL: instanceURL,
+			Current:     name == active,
+		})
+	}
+
+	switch output {
+	case formatTable:
+		if len(summaries) == 0 {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "No contexts defined in the config file")
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "CURRENT\tNAME\tINSTANCE_URL"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, summary := range summaries {
+			current := ""
+			if summary.Current {
+				current = "*"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", current, summary.Name, summary.InstanceURL); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(summaries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling contexts to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(summaries)
+		if err != nil {
+			return fmt.Errorf("error marshaling contexts to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// SetCmd represents the set command
+var SetCmd = &cobra.Command{
+	Use:   "set KEY VALUE",
+	Short: "Set a value in the config file",
+	Long: `Set a value in the config file in use, or in $HOME/.n8n/config.yaml when there
+is none. Nested keys are joined with dots, and the keys of a context are set with
+contexts.NAME.KEY. The value is validated against the type of the key; lists such
+as auth.args are given as comma separated values. Comments in the config file are kept.`,
+	Example: `  n8n config set timeout 30s
+  n8n config set contexts.prod.instance_url https://n8n.example.com
+  n8n config set auth.args auth,print-identity-token`,
+	Args: cobra.ExactArgs(2),
+	RunE: set,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(SetCmd)
+}
+
+func set(cmd *cobra.Command, args []string) error {
+	key, path, err := n8nconfig.ResolveKeyPath(args[0])
+	if err != nil {
+		return err
+	}
+
+	value, err := n8nconfig.ParseValue(key, args[1])
+	if err != nil {
+		return err
+	}
+
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	if err := file.Set(path, value); err != nil {
+		return err
+	}
+
+	if err := file.Save(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Set %s in %s\n", strings.Join(path, "."), file.Path()); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
+
+// loadConfigFile loads the config file in use for editing
+func loadConfigFile() (*n8nconfig.File, error) {
+	path, err := n8nconfig.ConfigFilePath(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+
+	return n8nconfig.LoadFile(path)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+)
+
+// UnsetCmd represents the unset command
+var UnsetCmd = &cobra.Command{
+	Use:   "unset KEY",
+	Short: "Remove a value from the config file",
+	Long: `Remove a value from the config file in use. Keys are given as for "n8n config set";
+unsetting a section such as auth removes all of its keys. Unknown keys reported by
+"n8n config validate" can be removed as well.`,
+	Example: `  n8n config unset timeout
+  n8n config unset contexts.prod.api_key`,
+	Args: cobra.ExactArgs(1),
+	RunE: unset,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(UnsetCmd)
+}
+
+func unset(cmd *cobra.Command, args []string) error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	_, path, err := n8nconfig.ResolveKeyPath(args[0])
+	if err != nil {
+		// Let misspelled or misplaced keys be removed as they are written in the file
+		if path = strings.Split(args[0], "."); !file.Unset(path) {
+			return err
+		}
+	} else if !file.Unset(path) {
+		return fmt.Errorf("%s is not set in %s", strings.Join(path, "."), file.Path())
+	}
+
+	if err := file.Save(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Unset %s in %s\n", strings.Join(path, "."), file.Path()); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
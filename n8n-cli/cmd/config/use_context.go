@@ -0,0 +1,80 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// UseContextCmd represents the use-context command
+var UseContextCmd = &cobra.Command{
+	Use:   "use-context CONTEXT_NAME",
+	Short: "Set the current context in the config file",
+	Long: `Set the current-context in the config file. Every following command uses the
+instance and settings of this context unless --context or N8N_CONTEXT selects another one.`,
+	Example: `  n8n config use-context prod`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    useContext,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(UseContextCmd)
+}
+
+func useContext(cmd *cobra.Command, args []string) error {
+	name := strings.ToLower(args[0])
+
+	v := viper.GetViper()
+	if _, ok := n8nconfig.Contexts(v)[name]; !ok {
+		return fmt.Errorf("context %q not found in config file (available: %v)", args[0], n8nconfig.ContextNames(v))
+	}
+
+	path, err := n8nconfig.ConfigFilePath(v)
+	if err != nil {
+		return err
+	}
+
+	file, err := n8nconfig.LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Set([]string{n8nconfig.CurrentContextKey}, name); err != nil {
+		return err
+	}
+
+	if err := file.Save(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q\n", name); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
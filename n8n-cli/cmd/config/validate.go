@@ -0,0 +1,101 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ValidateCmd represents the validate command
+var ValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration for unknown keys and invalid values",
+	Long: `Check the config files, environment variables, the .env file and the flags for
+unknown keys, malformed URLs and values of the wrong type, and check that the
+active context exists. Every problem is listed and the command exits with a
+non-zero status when any is found.`,
+	Args: cobra.NoArgs,
+	RunE: validate,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(ValidateCmd)
+}
+
+func validate(cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+	v := viper.GetViper()
+	loaded := v.ConfigFileUsed()
+
+	var problems []string
+	for _, path := range n8nconfig.ConfigFiles(v) {
+		settings, err := n8nconfig.ReadSettings(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		if path != loaded && loaded != "" {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s is not loaded because %s takes precedence\n", path, loaded)
+		}
+
+		if _, err := fmt.Fprintf(out, "Checking %s\n", path); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, problem := range n8nconfig.ValidateSettings(settings) {
+			problems = append(problems, fmt.Sprintf("%s: %v", path, problem))
+		}
+	}
+
+	for _, problem := range n8nconfig.ValidateEnvironment(cmd.Flags()) {
+		problems = append(problems, problem.Error())
+	}
+
+	if err := n8nconfig.ValidateContext(v); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) == 0 {
+		if _, err := fmt.Fprintln(out, "Configuration is valid"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		return nil
+	}
+
+	for _, problem := range problems {
+		if _, err := fmt.Fprintf(out, "  - %s\n", problem); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	return fmt.Errorf("configuration has %d problem(s)", len(problems))
+}
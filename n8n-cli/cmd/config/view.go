@@ -0,0 +1,90 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// ViewCmd represents the view command
+var ViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show the resolved configuration",
+	Long: `Show the configuration the CLI resolved from flags, environment variables,
+the .env file, the active context and the config file. Secrets such as the API
+key, auth.token and auth.headers are redacted.
+
+Use "n8n config explain KEY" to see where a value comes from.`,
+	Args: cobra.NoArgs,
+	RunE: view,
+}
+
+func init() {
+	rootcmd.GetConfigCmd().AddCommand(ViewCmd)
+
+	ViewCmd.Flags().StringP("output", "o", formatYAML, "Output format: json or yaml")
+}
+
+func view(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	v := viper.GetViper()
+	settings := make(map[string]interface{})
+	for name, value := range v.AllSettings() {
+		// Skip unknown keys, such as N8N_ variables of the .env file meant for n8n itself
+		if _, ok := n8nconfig.LookupKey(name); !ok || value == nil || value == "" {
+			continue
+		}
+		settings[name] = value
+	}
+	if active := n8nconfig.ActiveContext(v); active != "" {
+		settings[n8nconfig.ContextKey] = active
+	}
+
+	redacted := n8nconfig.Redact("", settings)
+
+	switch output {
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(redacted, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling configuration to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(redacted)
+		if err != nil {
+			return fmt.Errorf("error marshaling configuration to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: json, yaml", output)
+	}
+}
@@ -22,6 +22,7 @@ THE SOFTWARE.
 package credentials
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -31,7 +32,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -47,14 +47,30 @@ var (
 	credentialData     string
 	credentialDataFile string
 	credentialOutput   string
+
+	credentialInteractive    bool
+	credentialSkipValidation bool
 )
 
 // CreateCmd represents the create credential command
 var CreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a credential",
-	Long:  `Create a credential that can be used by nodes of the specified type.`,
-	RunE:  createCredential,
+	Long: `Create a credential that can be used by nodes of the specified type.
+
+The data is checked against the schema of the credential type before the credential
+is created, and every field that does not match is reported. With --interactive the
+fields of the schema are prompted for instead, without echoing the values of fields
+that look like secrets.
+
+String values of the data can reference a secret instead of containing it, so data
+files can be committed: "env:NAME" reads an environment variable, "file:PATH" the
+content of a file and "exec:COMMAND" what a command prints. References are resolved
+before any API call.`,
+	Example: `  n8n credentials create --name "GitHub" --type githubApi --data-file github.json
+  n8n credentials create --name "Slack" --type slackApi --data '{"accessToken": "env:SLACK_TOKEN"}'
+  n8n credentials create --name "GitHub" --type githubApi --interactive`,
+	RunE: createCredential,
 }
 
 func init() {
@@ -64,6 +80,8 @@ func init() {
 	CreateCmd.Flags().StringVarP(&credentialType, "type", "t", "", "Credential type name (use credentials schema to inspect fields)")
 	CreateCmd.Flags().StringVarP(&credentialData, "data", "d", "", "Credential data as a JSON object string")
 	CreateCmd.Flags().StringVar(&credentialDataFile, "data-file", "", "Path to a JSON file containing credential data JSON")
+	CreateCmd.Flags().BoolVarP(&credentialInteractive, "interactive", "i", false, "Prompt for each field of the credential type instead of reading the data")
+	CreateCmd.Flags().BoolVar(&credentialSkipValidation, "skip-validation", false, "Do not check the data against the schema of the credential type")
 	CreateCmd.Flags().StringVarP(&credentialOutput, "output", "o", credentialOutputText, "Output format: text, json, or yaml")
 
 	_ = CreateCmd.MarkFlagRequired("name")
@@ -71,30 +89,55 @@ func init() {
 }
 
 func createCredential(cmd *cobra.Command, args []string) error {
-	data, err := parseCredentialData(credentialData, credentialDataFile)
+	ctx := rootcmd.CommandContext(cmd)
+
+	var data map[string]interface{}
+	if credentialInteractive {
+		if credentialData != "" || credentialDataFile != "" {
+			return fmt.Errorf("use either --interactive or --data/--data-file, not both")
+		}
+	} else {
+		parsed, err := parseCredentialData(ctx, credentialData, credentialDataFile)
+		if err != nil {
+			return err
+		}
+		data = parsed
+	}
+
+	client, err := rootcmd.NewAPIClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	apiKey, ok := viper.Get("api_key").(string)
-	if !ok || apiKey == "" {
-		return fmt.Errorf("API key not found in configuration")
-	}
+	if credentialInteractive || !credentialSkipValidation {
+		schema, err := client.GetCredentialSchema(ctx, credentialType)
+		if err != nil {
+			_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching credential schema: %v\n", err)
+			if printErr != nil {
+				return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+			}
+			return err
+		}
 
-	instanceURL, ok := viper.Get("instance_url").(string)
-	if !ok || instanceURL == "" {
-		return fmt.Errorf("instance URL not found in configuration")
+		if credentialInteractive {
+			if data, err = promptCredentialData(cmd, schema); err != nil {
+				return err
+			}
+		}
+		if !credentialSkipValidation {
+			if err := validateCredentialData(cmd, schema, credentialType, data); err != nil {
+				return err
+			}
+		}
 	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
-
 	credential := n8n.Credential{
 		Name: credentialName,
 		Type: credentialType,
 		Data: &data,
 	}
 
-	created, err := client.CreateCredential(&credential)
+	created, err := client.CreateCredential(ctx, &credential)
 	if err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error creating credential: %v\n", err)
 		if printErr != nil {
@@ -103,10 +146,12 @@ func createCredential(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return writeCredentialOutput(cmd, created, credentialOutput)
+	return writeCredentialOutput(cmd, created, credentialOutput, "created")
 }
 
-func parseCredentialData(data string, dataFile string) (map[string]interface{}, error) {
+// parseCredentialData reads the credential data from the flags and resolves the secret
+// references in it, see resolveSecretRefs
+func parseCredentialData(ctx context.Context, data string, dataFile string) (map[string]interface{}, error) {
 	if data != "" && dataFile != "" {
 		return nil, fmt.Errorf("use either --data or --data-file, not both")
 	}
@@ -135,10 +180,12 @@ func parseCredentialData(data string, dataFile string) (map[string]interface{},
 		return nil, fmt.Errorf("credential data cannot be empty")
 	}
 
-	return parsed, nil
+	return resolveSecretRefs(ctx, parsed)
 }
 
-func writeCredentialOutput(cmd *cobra.Command, created *n8n.CreateCredentialResponse, format string) error {
+// writeCredentialOutput prints a created or updated credential, action is used in
+// the text output
+func writeCredentialOutput(cmd *cobra.Command, created *n8n.CreateCredentialResponse, format string, action string) error {
 	switch strings.ToLower(format) {
 	case credentialOutputJSON:
 		jsonData, err := json.MarshalIndent(created, "", "  ")
@@ -155,7 +202,7 @@ func writeCredentialOutput(cmd *cobra.Command, created *n8n.CreateCredentialResp
 		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(yamlData))
 		return err
 	case credentialOutputText:
-		_, err := fmt.Fprintln(cmd.OutOrStdout(), "Credential created successfully")
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Credential %s successfully\n", action)
 		if err != nil {
 			return fmt.Errorf("failed to write output: %v", err)
 		}
@@ -0,0 +1,178 @@
+package credentials
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// secretFieldWords are parts of field names whose values are not echoed when prompted for
+var secretFieldWords = []string{"password", "secret", "token", "passphrase"}
+
+// validateCredentialData prints every field of the data that does not match the schema
+// of the credential type, so typos are reported before n8n rejects the credential
+func validateCredentialData(cmd *cobra.Command, schema map[string]interface{}, credentialType string, data map[string]interface{}) error {
+	violations := n8n.ValidateCredentialData(schema, data)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	lines := []string{fmt.Sprintf("Credential data does not match the schema of type '%s':", credentialType)}
+	for _, violation := range violations {
+		lines = append(lines, "  "+violation.Error())
+	}
+	if _, err := fmt.Fprintln(cmd.ErrOrStderr(), strings.Join(lines, "\n")); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return fmt.Errorf("invalid credential data: %d problem(s), use 'credentials schema %s' to inspect the fields", len(violations), credentialType)
+}
+
+// promptCredentialData asks for every field of the credential schema, the input of
+// fields that look like secrets is not echoed when stdin is a terminal
+func promptCredentialData(cmd *cobra.Command, schema map[string]interface{}) (map[string]interface{}, error) {
+	properties := n8n.CredentialSchemaProperties(schema)
+	if len(properties) == 0 {
+		return nil, fmt.Errorf("the credential type has no fields to prompt for, use --data or --data-file")
+	}
+
+	reader := bufio.NewReader(cmd.InOrStdin())
+	terminal := -1
+	if file, ok := cmd.InOrStdin().(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		terminal = int(file.Fd())
+	}
+	out := cmd.ErrOrStderr()
+
+	data := make(map[string]interface{})
+	for _, property := range properties {
+		for {
+			if _, err := fmt.Fprint(out, propertyPrompt(property)); err != nil {
+				return nil, fmt.Errorf("failed to write output: %v", err)
+			}
+
+			input, err := readCredentialInput(reader, out, terminal, isSecretField(property.Name))
+			if err != nil {
+				return nil, fmt.Errorf("no value given for '%s': %w", property.Name, err)
+			}
+			if input == "" {
+				if !property.Required {
+					break
+				}
+				if _, err := fmt.Fprintf(out, "'%s' is required\n", property.Name); err != nil {
+					return nil, fmt.Errorf("failed to write output: %v", err)
+				}
+				continue
+			}
+
+			value, err := parseCredentialInput(property, input)
+			if err != nil {
+				if _, printErr := fmt.Fprintf(out, "Invalid value for '%s': %v\n", property.Name, err); printErr != nil {
+					return nil, fmt.Errorf("failed to write output: %v", printErr)
+				}
+				continue
+			}
+			data[property.Name] = value
+			break
+		}
+	}
+
+	return data, nil
+}
+
+func propertyPrompt(property n8n.SchemaProperty) string {
+	details := []string{}
+	if property.Type != "" {
+		details = append(details, property.Type)
+	}
+	if property.Required {
+		details = append(details, "required")
+	}
+	if len(property.Enum) > 0 {
+		options := make([]string, len(property.Enum))
+		for i, option := range property.Enum {
+			options[i] = fmt.Sprint(option)
+		}
+		details = append(details, "one of "+strings.Join(options, ", "))
+	}
+
+	if len(details) == 0 {
+		return property.Name + ": "
+	}
+	return fmt.Sprintf("%s (%s): ", property.Name, strings.Join(details, "; "))
+}
+
+func readCredentialInput(reader *bufio.Reader, out io.Writer, terminal int, secret bool) (string, error) {
+	if secret && terminal >= 0 {
+		input, err := term.ReadPassword(terminal)
+		_, _ = fmt.Fprintln(out)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(input)), nil
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// parseCredentialInput converts the prompted text to the type of the field
+func parseCredentialInput(property n8n.SchemaProperty, input string) (interface{}, error) {
+	var value interface{}
+	switch property.Type {
+	case "number":
+		number, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number")
+		}
+		value = number
+	case "integer":
+		number, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer")
+		}
+		value = float64(number)
+	case "boolean":
+		boolean, err := strconv.ParseBool(input)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false")
+		}
+		value = boolean
+	case "object", "array":
+		if err := json.Unmarshal([]byte(input), &value); err != nil {
+			return nil, fmt.Errorf("expected JSON: %v", err)
+		}
+	default:
+		value = input
+	}
+
+	if len(property.Enum) > 0 {
+		for _, option := range property.Enum {
+			if fmt.Sprint(option) == fmt.Sprint(value) {
+				return option, nil
+			}
+		}
+		return nil, fmt.Errorf("expected one of the listed values")
+	}
+	return value, nil
+}
+
+func isSecretField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, word := range secretFieldWords {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+	return strings.HasSuffix(lower, "key")
+}
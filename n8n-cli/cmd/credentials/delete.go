@@ -25,9 +25,7 @@ import (
 	"fmt"
 
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
-	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // DeleteCmd represents the delete credential command
@@ -46,19 +44,13 @@ func init() {
 func deleteCredential(cmd *cobra.Command, args []string) error {
 	credentialID := args[0]
 
-	apiKey, ok := viper.Get("api_key").(string)
-	if !ok || apiKey == "" {
-		return fmt.Errorf("API key not found in configuration")
-	}
-
-	instanceURL, ok := viper.Get("instance_url").(string)
-	if !ok || instanceURL == "" {
-		return fmt.Errorf("instance URL not found in configuration")
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
 	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
-
-	credential, err := client.DeleteCredential(credentialID)
+	credential, err := client.DeleteCredential(ctx, credentialID)
 	if err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting credential: %v\n", err)
 		if printErr != nil {
@@ -0,0 +1,188 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultStateFile is the name of the state file in the manifests directory
+const defaultStateFile = ".n8n-credentials-state.yaml"
+
+// credentialManifest is a credential as declared in a manifest file:
+//
+//	name: Slack
+//	type: slackApi
+//	data:
+//	  accessToken: env:SLACK_TOKEN
+type credentialManifest struct {
+	Name string                 `yaml:"name"`
+	Type string                 `yaml:"type"`
+	Data map[string]interface{} `yaml:"data"`
+
+	file string
+}
+
+// credentialsState maps the manifest names to the IDs of the credentials the sync
+// command created, per instance, since the API cannot list credentials
+type credentialsState struct {
+	Instances map[string]map[string]credentialStateEntry `yaml:"instances"`
+}
+
+// credentialStateEntry is a credential created by the sync command. Hash is the
+// keyed digest of the data last sent to the instance, see credentialManifest.hash;
+// the data itself is never stored.
+type credentialStateEntry struct {
+	ID   string `yaml:"id"`
+	Type string `yaml:"type"`
+	Hash string `yaml:"hash,omitempty"`
+}
+
+// hash returns the HMAC-SHA256 of the name, type and resolved data of the manifest,
+// so the sync command can tell whether the credential changed. The state file is
+// committed, so the digest is keyed with the API key of the instance: without the
+// key, the digest does not allow guessing the secrets offline.
+func (m credentialManifest) hash(key string) (string, error) {
+	// Maps are encoded with sorted keys, so equal data has the same digest
+	encoded, err := json.Marshal(map[string]interface{}{"name": m.Name, "type": m.Type, "data": m.Data})
+	if err != nil {
+		return "", fmt.Errorf("credential manifest %s: invalid 'data': %w", m.file, err)
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(encoded)
+	return "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// readCredentialManifests reads the YAML or JSON manifests of a directory sorted by
+// name, and resolves the secret references of their data. Files starting with a dot,
+// such as the state file, are skipped.
+func readCredentialManifests(ctx context.Context, directory string) ([]credentialManifest, error) {
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory: %w", err)
+	}
+
+	var manifests []credentialManifest
+	seen := make(map[string]string)
+	for _, file := range files {
+		ext := strings.ToLower(filepath.Ext(file.Name()))
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+
+		path := filepath.Join(directory, file.Name())
+		manifest, err := readCredentialManifest(path)
+		if err != nil {
+			return nil, err
+		}
+		if other, exists := seen[manifest.Name]; exists {
+			return nil, fmt.Errorf("credential '%s' is declared in both %s and %s", manifest.Name, other, path)
+		}
+		seen[manifest.Name] = path
+
+		if manifest.Data, err = resolveSecretRefs(ctx, manifest.Data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+	return manifests, nil
+}
+
+func readCredentialManifest(path string) (credentialManifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return credentialManifest{}, fmt.Errorf("error reading credential manifest: %w", err)
+	}
+
+	var manifest credentialManifest
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
+		return credentialManifest{}, fmt.Errorf("error parsing credential manifest %s: %w", path, err)
+	}
+
+	switch {
+	case manifest.Name == "":
+		return credentialManifest{}, fmt.Errorf("credential manifest %s has no 'name'", path)
+	case manifest.Type == "":
+		return credentialManifest{}, fmt.Errorf("credential manifest %s has no 'type'", path)
+	case len(manifest.Data) == 0:
+		return credentialManifest{}, fmt.Errorf("credential manifest %s has no 'data'", path)
+	}
+
+	// YAML numbers decode as int, round trip the data through JSON so it is typed
+	// the way the schema validation and the API expect
+	encoded, err := json.Marshal(manifest.Data)
+	if err != nil {
+		return credentialManifest{}, fmt.Errorf("credential manifest %s: invalid 'data': %w", path, err)
+	}
+	manifest.Data = nil
+	if err := json.Unmarshal(encoded, &manifest.Data); err != nil {
+		return credentialManifest{}, fmt.Errorf("credential manifest %s: invalid 'data': %w", path, err)
+	}
+
+	manifest.file = path
+	return manifest, nil
+}
+
+// loadCredentialsState reads the state file, a missing file is an empty state
+func loadCredentialsState(path string) (*credentialsState, error) {
+	state := &credentialsState{}
+
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error reading state file: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(content, state); err != nil {
+			return nil, fmt.Errorf("error parsing state file %s: %w", path, err)
+		}
+	}
+
+	if state.Instances == nil {
+		state.Instances = make(map[string]map[string]credentialStateEntry)
+	}
+	return state, nil
+}
+
+// instance returns the credentials created on an instance by manifest name
+func (s *credentialsState) instance(baseURL string) map[string]credentialStateEntry {
+	if s.Instances[baseURL] == nil {
+		s.Instances[baseURL] = make(map[string]credentialStateEntry)
+	}
+	return s.Instances[baseURL]
+}
+
+func (s *credentialsState) save(path string) error {
+	for baseURL, credentials := range s.Instances {
+		if len(credentials) == 0 {
+			delete(s.Instances, baseURL)
+		}
+	}
+
+	content, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error encoding state file: %w", err)
+	}
+	header := "# Credentials created by 'n8n credentials sync', commit this file next to the manifests\n"
+	if err := os.WriteFile(path, append([]byte(header), content...), 0600); err != nil {
+		return fmt.Errorf("error writing state file: %w", err)
+	}
+	return nil
+}
@@ -27,9 +27,7 @@ import (
 	"strings"
 
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
-	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -53,19 +51,13 @@ func init() {
 func showCredentialSchema(cmd *cobra.Command, args []string) error {
 	credentialType := args[0]
 
-	apiKey, ok := viper.Get("api_key").(string)
-	if !ok || apiKey == "" {
-		return fmt.Errorf("API key not found in configuration")
-	}
-
-	instanceURL, ok := viper.Get("instance_url").(string)
-	if !ok || instanceURL == "" {
-		return fmt.Errorf("instance URL not found in configuration")
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
 	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
-
-	schema, err := client.GetCredentialSchema(credentialType)
+	schema, err := client.GetCredentialSchema(ctx, credentialType)
 	if err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching credential schema: %v\n", err)
 		if printErr != nil {
@@ -0,0 +1,110 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/edenreich/n8n-cli/logger"
+)
+
+const (
+	secretRefEnv  = "env:"
+	secretRefFile = "file:"
+	secretRefExec = "exec:"
+)
+
+// resolveSecretRefs replaces the string values of the credential data that reference a
+// secret with the secret, so data files can be committed without the secrets:
+//
+//	"env:SLACK_TOKEN"                            the environment variable SLACK_TOKEN
+//	"file:/run/secrets/pg_password"              the content of the file
+//	"exec:vault kv get -field=token secret/slack" what the command prints
+//
+// Trailing newlines are removed from files and command output. The resolved values are
+// never logged or part of an error.
+func resolveSecretRefs(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
+	resolved, err := resolveSecretValue(ctx, data, "")
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]interface{}), nil
+}
+
+func resolveSecretValue(ctx context.Context, value interface{}, path string) (interface{}, error) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		names := make([]string, 0, len(typed))
+		for name := range typed {
+			names = append(names, name)
+		}
+		// Sorted, so commands run and errors are reported in a stable order
+		sort.Strings(names)
+
+		resolved := make(map[string]interface{}, len(typed))
+		for _, name := range names {
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			field, err := resolveSecretValue(ctx, typed[name], fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			resolved[name] = field
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(typed))
+		for i, item := range typed {
+			field, err := resolveSecretValue(ctx, item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = field
+		}
+		return resolved, nil
+	case string:
+		return resolveSecretRef(ctx, typed, path)
+	default:
+		return value, nil
+	}
+}
+
+func resolveSecretRef(ctx context.Context, value string, path string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(value, secretRefEnv):
+		name := strings.TrimPrefix(value, secretRefEnv)
+		secret, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("credential field '%s': environment variable %s is not set", path, name)
+		}
+		logger.Debug("Resolved credential field '%s' from environment variable %s", path, name)
+		return secret, nil
+	case strings.HasPrefix(value, secretRefFile):
+		file := strings.TrimPrefix(value, secretRefFile)
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("credential field '%s': error reading secret file: %w", path, err)
+		}
+		logger.Debug("Resolved credential field '%s' from file %s", path, file)
+		return strings.TrimRight(string(content), "\r\n"), nil
+	case strings.HasPrefix(value, secretRefExec):
+		command := strings.TrimPrefix(value, secretRefExec)
+		output, err := config.RunShellCommand(ctx, command)
+		if err != nil {
+			return nil, fmt.Errorf("credential field '%s': secret command failed: %w", path, err)
+		}
+		secret := strings.TrimRight(output, "\r\n")
+		if secret == "" {
+			return nil, fmt.Errorf("credential field '%s': secret command printed nothing", path)
+		}
+		logger.Debug("Resolved credential field '%s' from command output", path)
+		return secret, nil
+	default:
+		return value, nil
+	}
+}
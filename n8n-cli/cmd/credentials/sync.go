@@ -0,0 +1,280 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// SyncCmd represents the sync credentials command
+var SyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Reconcile the credentials of the n8n instance with a directory of manifests",
+	Long: `Reconcile the credentials of the n8n instance with a directory of YAML or JSON
+manifests, so credentials can be kept in version control next to the workflows.
+The data of a manifest references its secrets as described in
+'credentials create --help':
+
+  name: Slack
+  type: slackApi
+  data:
+    accessToken: env:SLACK_TOKEN
+
+Credentials without a credential on the instance are created, the others are
+updated with the data of their manifest when it changed since the last sync. The
+API cannot list credentials, so the IDs of the credentials created by sync are
+kept per instance in a state file, .n8n-credentials-state.yaml in the directory
+unless --state-file is given, together with an HMAC of the data they were last
+synced with, keyed with the API key. A credential deleted on the instance is
+created again. The API does not return credential data, so changes made to the
+data on the instance are not detected: --force sends the data of every manifest
+again and overwrites them. With --prune, the credentials sync created whose manifest was removed are deleted;
+other credentials of the instance are never touched. Data is never printed.`,
+	Example: `  # Preview the changes
+  n8n credentials sync -d credentials/ --dry-run
+
+  # Apply them and delete the credentials whose manifest was removed
+  n8n credentials sync -d credentials/ --prune
+
+  # Overwrite changes made to the credentials on the instance
+  n8n credentials sync -d credentials/ --force`,
+	Args: cobra.NoArgs,
+	RunE: syncCredentials,
+}
+
+func init() {
+	rootcmd.GetCredentialsCmd().AddCommand(SyncCmd)
+
+	SyncCmd.Flags().StringP("directory", "d", "", "Directory containing credential manifests (YAML or JSON)")
+	SyncCmd.Flags().String("state-file", "", "State file with the IDs of the created credentials (default <directory>/"+defaultStateFile+")")
+	SyncCmd.Flags().Bool("dry-run", false, "Show what would change without making changes")
+	SyncCmd.Flags().Bool("prune", false, "Delete credentials created by sync whose manifest was removed")
+	SyncCmd.Flags().Bool("skip-validation", false, "Do not check the data against the schema of the credential types")
+	SyncCmd.Flags().Bool("force", false, "Send the data of every manifest, overwriting changes made on the instance")
+
+	_ = SyncCmd.MarkFlagRequired("directory")
+	// nolint:errcheck
+	SyncCmd.MarkFlagDirname("directory")
+}
+
+func syncCredentials(cmd *cobra.Command, args []string) error {
+	directory, _ := cmd.Flags().GetString("directory")
+	statePath, _ := cmd.Flags().GetString("state-file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	prune, _ := cmd.Flags().GetBool("prune")
+	skipValidation, _ := cmd.Flags().GetBool("skip-validation")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if statePath == "" {
+		statePath = filepath.Join(directory, defaultStateFile)
+	}
+	ctx := rootcmd.CommandContext(cmd)
+
+	// Secret references are resolved before any API call
+	manifests, err := readCredentialManifests(ctx, directory)
+	if err != nil {
+		return err
+	}
+	state, err := loadCredentialsState(statePath)
+	if err != nil {
+		return err
+	}
+
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+	created := state.instance(client.BaseURL())
+	// The API key was resolved by NewAPIClient, this returns the same key
+	hashKey, err := config.ResolveAPIKey(ctx, viper.GetViper())
+	if err != nil {
+		return err
+	}
+
+	if !skipValidation {
+		if err := validateManifests(ctx, cmd, client, manifests); err != nil {
+			return err
+		}
+	}
+
+	applier := rootcmd.NewSyncApplier(cmd, dryRun)
+	summary := &applier.Summary
+
+	createCredential := func(manifest credentialManifest, hash string) (*int, string, error) {
+		data := manifest.Data
+		credential, err := client.CreateCredential(ctx, &n8n.Credential{Name: manifest.Name, Type: manifest.Type, Data: &data})
+		if err != nil {
+			return nil, "", fmt.Errorf("error creating credential '%s': %w", manifest.Name, err)
+		}
+		if credential.Id == nil {
+			return nil, "", fmt.Errorf("error creating credential '%s': the response has no ID", manifest.Name)
+		}
+		created[manifest.Name] = credentialStateEntry{ID: *credential.Id, Type: manifest.Type, Hash: hash}
+		return &summary.Created, fmt.Sprintf("Created credential '%s' (ID: %s)", manifest.Name, *credential.Id), nil
+	}
+
+	for _, manifest := range manifests {
+		entry, exists := created[manifest.Name]
+		hash, hashErr := manifest.hash(hashKey)
+		if hashErr != nil {
+			return saveAfterError(state, statePath, dryRun, hashErr)
+		}
+
+		switch {
+		case !exists:
+			err = applier.Apply(&summary.Created,
+				fmt.Sprintf("Would create credential '%s'", manifest.Name),
+				func() (*int, string, error) { return createCredential(manifest, hash) })
+		case entry.Type != manifest.Type:
+			summary.Failed++
+			typeErr := fmt.Errorf("credential '%s' (ID: %s) has type %s, its type cannot be changed to %s, rename the manifest to create a new credential",
+				manifest.Name, entry.ID, entry.Type, manifest.Type)
+			if _, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", typeErr); printErr != nil {
+				return saveAfterError(state, statePath, dryRun, fmt.Errorf("failed to write error: %v (original error: %v)", printErr, typeErr))
+			}
+		case entry.Hash == hash && !force:
+			// The API does not return the data, so a PATCH of the name alone confirms
+			// the credential still exists without sending the secrets again
+			err = applier.Apply(&summary.Unchanged, "", func() (*int, string, error) {
+				_, err := client.UpdateCredential(ctx, entry.ID, &n8n.CredentialUpdate{Name: manifest.Name})
+				if errors.Is(err, n8n.ErrNotFound) {
+					// Deleted on the instance, so it is created again and counted as created
+					delete(created, manifest.Name)
+					return createCredential(manifest, hash)
+				}
+				if err != nil {
+					return nil, "", fmt.Errorf("error checking credential '%s' (ID: %s): %w", manifest.Name, entry.ID, err)
+				}
+				return nil, "", nil
+			})
+		default:
+			err = applier.Apply(&summary.Updated,
+				fmt.Sprintf("Would update credential '%s' (ID: %s)", manifest.Name, entry.ID),
+				func() (*int, string, error) {
+					update := n8n.CredentialUpdate{Name: manifest.Name, Data: manifest.Data}
+					_, err := client.UpdateCredential(ctx, entry.ID, &update)
+					if errors.Is(err, n8n.ErrNotFound) {
+						// Deleted on the instance, so it is created again and counted as created
+						delete(created, manifest.Name)
+						return createCredential(manifest, hash)
+					}
+					if err != nil {
+						return nil, "", fmt.Errorf("error updating credential '%s' (ID: %s): %w", manifest.Name, entry.ID, err)
+					}
+					entry.Hash = hash
+					created[manifest.Name] = entry
+					return nil, fmt.Sprintf("Updated credential '%s' (ID: %s)", manifest.Name, entry.ID), nil
+				})
+		}
+		if err != nil {
+			return saveAfterError(state, statePath, dryRun, err)
+		}
+	}
+
+	if prune {
+		declared := make(map[string]bool, len(manifests))
+		for _, manifest := range manifests {
+			declared[manifest.Name] = true
+		}
+		var names []string
+		for name := range created {
+			if !declared[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			entry := created[name]
+			err = applier.Apply(&summary.Deleted,
+				fmt.Sprintf("Would delete credential '%s' (ID: %s) that has no manifest", name, entry.ID),
+				func() (*int, string, error) {
+					if _, err := client.DeleteCredential(ctx, entry.ID); err != nil && !errors.Is(err, n8n.ErrNotFound) {
+						return nil, "", fmt.Errorf("error deleting credential '%s' (ID: %s): %w", name, entry.ID, err)
+					}
+					delete(created, name)
+					return nil, fmt.Sprintf("Deleted credential '%s' (ID: %s) that has no manifest", name, entry.ID), nil
+				})
+			if err != nil {
+				return saveAfterError(state, statePath, dryRun, err)
+			}
+		}
+	}
+
+	if !dryRun {
+		if err := state.save(statePath); err != nil {
+			return err
+		}
+	}
+
+	return applier.Finish("credential")
+}
+
+// validateManifests checks the data of every manifest against the schema of its
+// credential type, fetching each schema once
+func validateManifests(ctx context.Context, cmd *cobra.Command, client n8n.ClientInterface, manifests []credentialManifest) error {
+	schemas := make(map[string]map[string]interface{})
+
+	invalid := 0
+	for _, manifest := range manifests {
+		schema, fetched := schemas[manifest.Type]
+		if !fetched {
+			var err error
+			if schema, err = client.GetCredentialSchema(ctx, manifest.Type); err != nil {
+				return fmt.Errorf("error fetching the schema of credential type %s for %s: %w", manifest.Type, manifest.file, err)
+			}
+			schemas[manifest.Type] = schema
+		}
+
+		if err := validateCredentialData(cmd, schema, manifest.Type, manifest.Data); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  in %s\n", manifest.file)
+			invalid++
+		}
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d credential manifest(s) do not match the schema of their type", invalid)
+	}
+	return nil
+}
+
+// saveAfterError records the credentials created before err, so a later sync does
+// not create them again
+func saveAfterError(state *credentialsState, path string, dryRun bool, err error) error {
+	if dryRun {
+		return err
+	}
+	if saveErr := state.save(path); saveErr != nil {
+		return fmt.Errorf("%w (%v)", err, saveErr)
+	}
+	return err
+}
@@ -25,9 +25,7 @@ import (
 	"fmt"
 
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
-	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var destinationProjectID string
@@ -51,19 +49,13 @@ func init() {
 func transferCredential(cmd *cobra.Command, args []string) error {
 	credentialID := args[0]
 
-	apiKey, ok := viper.Get("api_key").(string)
-	if !ok || apiKey == "" {
-		return fmt.Errorf("API key not found in configuration")
-	}
-
-	instanceURL, ok := viper.Get("instance_url").(string)
-	if !ok || instanceURL == "" {
-		return fmt.Errorf("instance URL not found in configuration")
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
 	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
-
-	if err := client.TransferCredential(credentialID, destinationProjectID); err != nil {
+	if err := client.TransferCredential(ctx, credentialID, destinationProjectID); err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error transferring credential: %v\n", err)
 		if printErr != nil {
 			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
@@ -71,7 +63,7 @@ func transferCredential(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, err := fmt.Fprintf(cmd.OutOrStdout(), "Credential with ID %s transferred to project %s\n", credentialID, destinationProjectID)
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "Credential with ID %s transferred to project %s\n", credentialID, destinationProjectID)
 	if err != nil {
 		return fmt.Errorf("failed to write output: %v", err)
 	}
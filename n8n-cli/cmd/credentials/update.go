@@ -0,0 +1,100 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package credentials
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// UpdateCmd represents the update credential command
+var UpdateCmd = &cobra.Command{
+	Use:   "update CREDENTIAL_ID",
+	Short: "Update the name or data of a credential",
+	Long: `Update a credential in place, so that the workflows using it keep working,
+for example to rotate an API token.
+
+The data given with --data or --data-file is merged into the existing fields of
+the credential, so only the changed fields need to be given. Use --replace to
+replace all fields with the given data instead. The data can reference secrets as
+described in 'credentials create --help'.`,
+	Example: `  n8n credentials update 42 --data '{"accessToken": "new-token"}'
+  n8n credentials update 42 --name "GitHub (rotated)" --data-file github.json --replace`,
+	Args: cobra.ExactArgs(1),
+	RunE: updateCredential,
+}
+
+func init() {
+	rootcmd.GetCredentialsCmd().AddCommand(UpdateCmd)
+
+	UpdateCmd.Flags().StringP("name", "n", "", "New credential name")
+	UpdateCmd.Flags().StringP("data", "d", "", "Credential data as a JSON object string")
+	UpdateCmd.Flags().String("data-file", "", "Path to a JSON file containing credential data JSON")
+	UpdateCmd.Flags().Bool("replace", false, "Replace all fields of the credential data instead of merging into them")
+	UpdateCmd.Flags().StringP("output", "o", credentialOutputText, "Output format: text, json, or yaml")
+}
+
+func updateCredential(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	data, _ := cmd.Flags().GetString("data")
+	dataFile, _ := cmd.Flags().GetString("data-file")
+	replace, _ := cmd.Flags().GetBool("replace")
+	output, _ := cmd.Flags().GetString("output")
+
+	if name == "" && data == "" && dataFile == "" {
+		return fmt.Errorf("nothing to update, use --name, --data or --data-file")
+	}
+	if replace && data == "" && dataFile == "" {
+		return fmt.Errorf("--replace requires --data or --data-file")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+
+	update := n8n.CredentialUpdate{Name: name}
+	if data != "" || dataFile != "" {
+		parsed, err := parseCredentialData(ctx, data, dataFile)
+		if err != nil {
+			return err
+		}
+		update.Data = parsed
+		update.IsPartialData = !replace
+	}
+
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := client.UpdateCredential(ctx, args[0], &update)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error updating credential: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	return writeCredentialOutput(cmd, updated, output, "updated")
+}
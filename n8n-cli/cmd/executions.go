@@ -0,0 +1,56 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// executionsCmd represents the executions command
+var executionsCmd = &cobra.Command{
+	Use:   "executions",
+	Short: "Manage n8n executions",
+	Long: `The executions command provides utilities to work with the executions
+of an n8n instance across workflows, such as inspecting and retrying
+failed executions.
+To list the executions of a single workflow use 'n8n workflows executions'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(executionsCmd)
+
+	executionsCmd.SetHelpCommand(&cobra.Command{
+		Use:   "help",
+		Short: "Help about executions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Parent().Help()
+		},
+	})
+}
+
+// GetExecutionsCmd returns the executions command for other packages
+func GetExecutionsCmd() *cobra.Command {
+	return executionsCmd
+}
@@ -0,0 +1,105 @@
+// Package executions contains commands for the n8n-cli executions.
+package executions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// maxExactID is the largest execution ID the float32 IDs of the generated
+// client types hold without rounding
+const maxExactID = 1 << 24
+
+// retryResult is the outcome of retrying one execution
+type retryResult struct {
+	ExecutionID    string `json:"executionId" yaml:"executionId"`
+	WorkflowID     string `json:"workflowId,omitempty" yaml:"workflowId,omitempty"`
+	NewExecutionID string `json:"newExecutionId,omitempty" yaml:"newExecutionId,omitempty"`
+	Status         string `json:"status" yaml:"status"`
+	DryRun         bool   `json:"dryRun" yaml:"dryRun"`
+	Error          string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// formatID formats an execution or workflow ID of the generated client types
+func formatID(id *float32) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatFloat(float64(*id), 'f', 0, 32)
+}
+
+// executionID returns the ID of an execution, or an error when the ID may have
+// been rounded and retrying it could hit another execution
+func executionID(execution n8n.Execution) (string, error) {
+	if execution.Id == nil {
+		return "", fmt.Errorf("execution has no ID")
+	}
+	if *execution.Id >= maxExactID {
+		return "", fmt.Errorf("execution ID %s is too large to be retried safely in bulk, retry it by ID instead", formatID(execution.Id))
+	}
+	return formatID(execution.Id), nil
+}
+
+// printRetryResults prints the results in the given output format
+func printRetryResults(cmd *cobra.Command, output string, results []retryResult) error {
+	switch output {
+	case formatTable:
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "OLD_ID\tNEW_ID\tWORKFLOW_ID\tSTATUS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, result := range results {
+			newID, workflowID := result.NewExecutionID, result.WorkflowID
+			if newID == "" {
+				newID = "N/A"
+			}
+			if workflowID == "" {
+				workflowID = "N/A"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.ExecutionID, newID, workflowID, result.Status); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling results to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("error marshaling results to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
+
+// validateOutput returns an error for an unsupported output format, so that
+// commands can reject it before changing anything
+func validateOutput(output string) error {
+	switch output {
+	case formatTable, formatJSON, formatYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
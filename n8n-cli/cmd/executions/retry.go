@@ -0,0 +1,255 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package executions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// retryPageLimit is the number of executions fetched per page in bulk mode
+const retryPageLimit = 100
+
+// RetryCmd represents the retry executions command
+var RetryCmd = &cobra.Command{
+	Use:   "retry [EXECUTION_ID]",
+	Short: "Retry a failed execution, or all failed executions of a workflow",
+	Long: `Retry a failed execution by its ID, or retry the failed executions of a
+workflow in bulk with --workflow.
+
+In bulk mode the executions are paged through and filtered by --status and
+--since, executions that were already retried successfully are skipped and the
+rest are retried with up to --concurrency retries at a time. A summary maps the
+old execution IDs to the IDs of the new executions.
+
+By default n8n retries an execution with the workflow as it was when the
+execution started. Use --load-workflow to retry with the currently saved workflow,
+for example after fixing the node that failed.`,
+	Example: `  n8n executions retry 1042
+  n8n executions retry --workflow 7 --status error --since 2h --load-workflow`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: retryExecutions,
+}
+
+func init() {
+	rootcmd.GetExecutionsCmd().AddCommand(RetryCmd)
+
+	RetryCmd.Flags().String("workflow", "", "Retry the executions of this workflow ID in bulk")
+	RetryCmd.Flags().String("status", "error", "Status of the executions to retry in bulk: error or canceled")
+	RetryCmd.Flags().Duration("since", 0, "Only retry executions started within this duration, such as 2h (0 for all)")
+	RetryCmd.Flags().Bool("load-workflow", false, "Retry with the currently saved workflow instead of the one the execution started with")
+	RetryCmd.Flags().Int("concurrency", 4, "Maximum number of retries running at the same time")
+	RetryCmd.Flags().Bool("dry-run", false, "Show the executions that would be retried without retrying them")
+	RetryCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func retryExecutions(cmd *cobra.Command, args []string) error {
+	workflowID, _ := cmd.Flags().GetString("workflow")
+	status, _ := cmd.Flags().GetString("status")
+	since, _ := cmd.Flags().GetDuration("since")
+	loadWorkflow, _ := cmd.Flags().GetBool("load-workflow")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	output, _ := cmd.Flags().GetString("output")
+
+	if err := validateOutput(output); err != nil {
+		return err
+	}
+	if len(args) == 1 && (workflowID != "" || since != 0) {
+		return fmt.Errorf("an execution ID cannot be combined with --workflow or --since")
+	}
+	if len(args) == 0 && workflowID == "" {
+		return fmt.Errorf("an execution ID or --workflow is required")
+	}
+	if status != "error" && status != "canceled" {
+		return fmt.Errorf("invalid status filter: %s. Valid values are: error, canceled", status)
+	}
+	if since < 0 {
+		return fmt.Errorf("--since must not be negative")
+	}
+	if concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var results []retryResult
+	if len(args) == 1 {
+		results, err = retryOne(ctx, client, args[0], loadWorkflow, dryRun)
+	} else {
+		var executions []n8n.Execution
+		executions, err = findExecutions(ctx, client, workflowID, status, since)
+		if err == nil {
+			results = retryAll(ctx, client, executions, loadWorkflow, dryRun, concurrency)
+		}
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error retrying executions: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if len(results) == 0 && output == formatTable {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), "No executions to retry.")
+		return err
+	}
+
+	failed, retried := 0, 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+			if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Error retrying execution %s: %s\n", result.ExecutionID, result.Error); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		} else if result.Status == "retried" || result.Status == "would be retried" {
+			retried++
+		}
+	}
+
+	if err := printRetryResults(cmd, output, results); err != nil {
+		return err
+	}
+
+	if output == formatTable {
+		summary := fmt.Sprintf("\nRetried %d of %d execution(s)\n", retried, len(results))
+		if dryRun {
+			summary = fmt.Sprintf("\n%d of %d execution(s) would be retried\n", retried, len(results))
+		}
+		if _, err := fmt.Fprint(cmd.OutOrStdout(), summary); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	if failed > 0 {
+		// The results are already printed, the usage would only obscure them
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to retry %d execution(s)", failed)
+	}
+
+	return nil
+}
+
+// retryOne retries a single execution by its ID. In dry-run mode it only checks
+// that the execution exists.
+func retryOne(ctx context.Context, client n8n.ClientInterface, id string, loadWorkflow, dryRun bool) ([]retryResult, error) {
+	if dryRun {
+		execution, err := client.GetExecutionById(ctx, id, false)
+		if err != nil {
+			return nil, err
+		}
+		return []retryResult{{ExecutionID: id, WorkflowID: formatID(execution.WorkflowId), Status: "would be retried", DryRun: true}}, nil
+	}
+
+	retried, err := client.RetryExecution(ctx, id, loadWorkflow)
+	if err != nil {
+		return nil, err
+	}
+	return []retryResult{{ExecutionID: id, WorkflowID: formatID(retried.WorkflowId), NewExecutionID: formatID(retried.Id), Status: "retried"}}, nil
+}
+
+// findExecutions pages through the executions of a workflow with the given
+// status and returns those started within since, or all of them when since is 0
+func findExecutions(ctx context.Context, client n8n.ClientInterface, workflowID, status string, since time.Duration) ([]n8n.Execution, error) {
+	var cutoff time.Time
+	if since > 0 {
+		cutoff = time.Now().Add(-since)
+	}
+
+	var matches []n8n.Execution
+	cursor := ""
+	for {
+		page, err := client.GetExecutions(ctx, workflowID, false, status, retryPageLimit, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if page.Data != nil {
+			for _, execution := range *page.Data {
+				if !cutoff.IsZero() && execution.StartedAt != nil && execution.StartedAt.Before(cutoff) {
+					// n8n returns the newest executions first, so the remaining pages are older
+					return matches, nil
+				}
+				matches = append(matches, execution)
+			}
+		}
+
+		if page.NextCursor == nil || *page.NextCursor == "" {
+			return matches, nil
+		}
+		cursor = *page.NextCursor
+	}
+}
+
+// retryAll retries the executions with at most concurrency retries at a time and
+// returns the results in the order of the executions
+func retryAll(ctx context.Context, client n8n.ClientInterface, executions []n8n.Execution, loadWorkflow, dryRun bool, concurrency int) []retryResult {
+	results := make([]retryResult, len(executions))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, execution := range executions {
+		results[i] = retryResult{ExecutionID: formatID(execution.Id), WorkflowID: formatID(execution.WorkflowId), DryRun: dryRun}
+
+		id, err := executionID(execution)
+		if err != nil {
+			results[i].Status, results[i].Error = "failed", err.Error()
+			continue
+		}
+		if execution.RetrySuccessId != nil {
+			results[i].Status = fmt.Sprintf("skipped, already retried as %s", formatID(execution.RetrySuccessId))
+			continue
+		}
+		if dryRun {
+			results[i].Status = "would be retried"
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(result *retryResult, id string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			retried, err := client.RetryExecution(ctx, id, loadWorkflow)
+			if err != nil {
+				result.Status, result.Error = "failed", err.Error()
+				return
+			}
+			result.NewExecutionID, result.Status = formatID(retried.Id), "retried"
+		}(&results[i], id)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,204 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package executions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// ShowCmd represents the show execution command
+var ShowCmd = &cobra.Command{
+	Use:   "show EXECUTION_ID",
+	Short: "Show the node runs of an execution",
+	Long: `Show an execution with the runs of its nodes in execution order: when each
+node started, how long it took, how many items it received and returned, and the
+error message and stack trace of the node that failed.
+
+Use --node to print the output of a single node as JSON, one entry per run of
+the node, or --raw to print the whole execution as returned by the API.`,
+	Example: `  n8n executions show 1042
+  n8n executions show 1042 --node "HTTP Request" | jq '.[0].main[0][].json'`,
+	Args: cobra.ExactArgs(1),
+	RunE: showExecution,
+}
+
+func init() {
+	rootcmd.GetExecutionsCmd().AddCommand(ShowCmd)
+
+	ShowCmd.Flags().String("node", "", "Print the output of this node as JSON")
+	ShowCmd.Flags().Bool("raw", false, "Print the whole execution as JSON")
+}
+
+func showExecution(cmd *cobra.Command, args []string) error {
+	nodeName, _ := cmd.Flags().GetString("node")
+	raw, _ := cmd.Flags().GetBool("raw")
+
+	if nodeName != "" && raw {
+		return fmt.Errorf("--node cannot be combined with --raw")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	execution, err := client.GetExecutionById(ctx, args[0], true)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error getting execution: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if raw {
+		jsonData, err := json.MarshalIndent(execution, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal execution: %v", err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", jsonData)
+		return err
+	}
+
+	runs, _ := n8n.ExecutionNodeRuns(*execution)
+
+	if nodeName != "" {
+		var outputs []map[string]interface{}
+		for _, run := range runs {
+			if run.Node == nodeName {
+				outputs = append(outputs, run.Output)
+			}
+		}
+		if outputs == nil {
+			return fmt.Errorf("node '%s' did not run in execution %s", nodeName, args[0])
+		}
+
+		jsonData, err := json.MarshalIndent(outputs, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal node output: %v", err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", jsonData)
+		return err
+	}
+
+	return printExecution(cmd.OutOrStdout(), args[0], execution, runs)
+}
+
+// printExecution prints the summary of an execution followed by its node runs
+// and the errors they failed with
+func printExecution(out io.Writer, id string, execution *n8n.Execution, runs []n8n.NodeRun) error {
+	status := "N/A"
+	if execution.Status != nil {
+		status = string(*execution.Status)
+	} else if execution.Finished != nil && *execution.Finished {
+		status = "success"
+	}
+	mode := "N/A"
+	if execution.Mode != nil {
+		mode = string(*execution.Mode)
+	}
+	started, duration := "N/A", "N/A"
+	if execution.StartedAt != nil {
+		started = execution.StartedAt.Format(time.RFC3339)
+		if execution.StoppedAt != nil {
+			duration = formatDuration(execution.StoppedAt.Sub(*execution.StartedAt))
+		}
+	}
+
+	workflow := ""
+	if execution.WorkflowId != nil {
+		workflow = fmt.Sprintf(" of workflow %s", formatID(execution.WorkflowId))
+	}
+	header := fmt.Sprintf("Execution %s%s\nStatus:   %s\nMode:     %s\nStarted:  %s\nDuration: %s\n\n", id, workflow, status, mode, started, duration)
+	if _, err := fmt.Fprint(out, header); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	if len(runs) == 0 {
+		_, err := fmt.Fprintln(out, "No run data, the execution is still running or its data has been pruned.")
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if _, err := fmt.Fprintln(w, "NODE\tRUN\tSTARTED\tDURATION\tITEMS_IN\tITEMS_OUT\tSTATUS"); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	for _, run := range runs {
+		runStatus := run.Status
+		if runStatus == "" {
+			runStatus = "N/A"
+		}
+		_, err := fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%d\t%d\t%s\n", run.Node, run.RunIndex, run.StartTime.Format("15:04:05.000"),
+			formatDuration(run.ExecutionTime), run.ItemsIn, run.ItemsOut, runStatus)
+		if err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	for _, run := range runs {
+		if run.Error == nil {
+			continue
+		}
+		if err := printNodeError(out, run); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printNodeError prints the error message, description and stack trace of a failed node run
+func printNodeError(out io.Writer, run n8n.NodeRun) error {
+	text := fmt.Sprintf("\nError in node '%s' (run %d): %s\n", run.Node, run.RunIndex, run.Error.Message)
+	if run.Error.Description != "" {
+		text += run.Error.Description + "\n"
+	}
+	if run.Error.Stack != "" {
+		text += "\n  " + strings.ReplaceAll(strings.TrimSpace(run.Error.Stack), "\n", "\n  ") + "\n"
+	}
+	if _, err := fmt.Fprint(out, text); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	return nil
+}
+
+// formatDuration formats a duration in milliseconds below a second and in
+// seconds above, like the executions list of a workflow
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
@@ -0,0 +1,70 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// CreateCmd represents the create project command
+var CreateCmd = &cobra.Command{
+	Use:     "create NAME",
+	Short:   "Create a team project",
+	Long:    `Create a team project in the n8n instance and print its ID.`,
+	Example: `  n8n projects create "Marketing Automations"`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    createProject,
+}
+
+func init() {
+	rootcmd.GetProjectsCmd().AddCommand(CreateCmd)
+}
+
+func createProject(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.CreateProject(ctx, args[0])
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error creating project: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	message := fmt.Sprintf("Project '%s' has been created successfully", project.Name)
+	if project.Id != nil {
+		message = fmt.Sprintf("Project '%s' (ID: %s) has been created successfully", project.Name, *project.Id)
+	}
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), message); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
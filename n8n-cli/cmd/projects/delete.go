@@ -0,0 +1,68 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCmd represents the delete project command
+var DeleteCmd = &cobra.Command{
+	Use:   "delete PROJECT",
+	Short: "Delete a project by name or ID",
+	Long:  `Delete a team project from your n8n instance by its ID or name.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  deleteProject,
+}
+
+func init() {
+	rootcmd.GetProjectsCmd().AddCommand(DeleteCmd)
+}
+
+func deleteProject(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := rootcmd.ResolveProject(ctx, client, args[0])
+	if err == nil {
+		err = client.DeleteProject(ctx, *project.Id)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting project: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Project '%s' (ID: %s) has been deleted successfully\n", project.Name, *project.Id); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
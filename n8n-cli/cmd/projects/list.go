@@ -0,0 +1,124 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// ListCmd represents the list projects command
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the projects of the n8n instance",
+	Long: `List the projects of the n8n instance, sorted by name. The ID in the
+output is what other commands, such as credentials transfer, expect as a
+project ID.`,
+	Args: cobra.NoArgs,
+	RunE: listProjects,
+}
+
+func init() {
+	rootcmd.GetProjectsCmd().AddCommand(ListCmd)
+
+	ListCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func listProjects(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectList, err := client.GetProjects(ctx)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching projects: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	projects := []n8n.Project{}
+	if projectList != nil && projectList.Data != nil {
+		projects = *projectList.Data
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		return projects[i].Name < projects[j].Name
+	})
+
+	switch output {
+	case formatTable:
+		if len(projects) == 0 {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "No projects found")
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tNAME\tTYPE"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, project := range projects {
+			id := "N/A"
+			if project.Id != nil {
+				id = *project.Id
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", id, project.Name, projectType(project)); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(projects, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling projects to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(projects)
+		if err != nil {
+			return fmt.Errorf("error marshaling projects to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
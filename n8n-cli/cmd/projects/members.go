@@ -0,0 +1,84 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// MembersCmd represents the project members command
+var MembersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "Manage the members of a project",
+	Long: `The members command lists the users of a team project, adds users to it
+with a project role and removes them again.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootcmd.GetProjectsCmd().AddCommand(MembersCmd)
+}
+
+// getMembers fetches the users of the project with the given ID
+func getMembers(ctx context.Context, client n8n.ClientInterface, projectID string) ([]n8n.User, error) {
+	userList, err := client.GetProjectMembers(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching project members: %w", err)
+	}
+
+	if userList == nil || userList.Data == nil {
+		return []n8n.User{}, nil
+	}
+	return *userList.Data, nil
+}
+
+// findMember returns the member with the given ID or email address
+func findMember(members []n8n.User, idOrEmail string) (*n8n.User, bool) {
+	for _, member := range members {
+		if (member.Id != nil && *member.Id == idOrEmail) || strings.EqualFold(string(member.Email), idOrEmail) {
+			return &member, true
+		}
+	}
+
+	return nil, false
+}
+
+// memberName returns the full name of a user, or an empty string when it is not set
+func memberName(user n8n.User) string {
+	var parts []string
+	if user.FirstName != nil && *user.FirstName != "" {
+		parts = append(parts, *user.FirstName)
+	}
+	if user.LastName != nil && *user.LastName != "" {
+		parts = append(parts, *user.LastName)
+	}
+
+	return strings.Join(parts, " ")
+}
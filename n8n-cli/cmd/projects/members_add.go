@@ -0,0 +1,86 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// MembersAddCmd represents the add project members command
+var MembersAddCmd = &cobra.Command{
+	Use:   "add PROJECT USER_ID...",
+	Short: "Add users to a project",
+	Long: `Add one or more users, identified by their user ID, to a project with
+the role given by --role: admin, editor or viewer.`,
+	Example: `  n8n projects members add "Marketing" 91765f0d-3b29-45df-adb9-35b23937eb92 --role editor`,
+	Args:    cobra.MinimumNArgs(2),
+	RunE:    addMembers,
+}
+
+func init() {
+	MembersCmd.AddCommand(MembersAddCmd)
+
+	MembersAddCmd.Flags().String("role", "viewer", "Project role of the users: admin, editor or viewer")
+}
+
+func addMembers(cmd *cobra.Command, args []string) error {
+	roleFlag, _ := cmd.Flags().GetString("role")
+	role, err := parseProjectRole(roleFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	userIDs := args[1:]
+	relations := make([]n8n.ProjectRelation, 0, len(userIDs))
+	for _, userID := range userIDs {
+		relations = append(relations, n8n.ProjectRelation{UserId: userID, Role: role})
+	}
+
+	project, err := rootcmd.ResolveProject(ctx, client, args[0])
+	if err == nil {
+		err = client.AddProjectMembers(ctx, *project.Id, relations)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error adding project members: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Added %s to project '%s' as %s\n", strings.Join(userIDs, ", "), project.Name, role); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// MembersListCmd represents the list project members command
+var MembersListCmd = &cobra.Command{
+	Use:   "list PROJECT",
+	Short: "List the members of a project",
+	Long:  `List the users of a project, identified by its ID or name, sorted by email address.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  listMembers,
+}
+
+func init() {
+	MembersCmd.AddCommand(MembersListCmd)
+
+	MembersListCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func listMembers(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := rootcmd.ResolveProject(ctx, client, args[0])
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching project members: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	members, err := getMembers(ctx, client, *project.Id)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching project members: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].Email < members[j].Email
+	})
+
+	switch output {
+	case formatTable:
+		if len(members) == 0 {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Project '%s' has no members\n", project.Name)
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tEMAIL\tNAME"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, member := range members {
+			id := "N/A"
+			if member.Id != nil {
+				id = *member.Id
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", id, member.Email, memberName(member)); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(members, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling project members to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(members)
+		if err != nil {
+			return fmt.Errorf("error marshaling project members to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// MembersRemoveCmd represents the remove project members command
+var MembersRemoveCmd = &cobra.Command{
+	Use:   "remove PROJECT USER...",
+	Short: "Remove users from a project",
+	Long: `Remove one or more users, identified by their user ID or email address,
+from a project. Every user must be a member of the project; nothing is
+removed otherwise.`,
+	Example: `  n8n projects members remove "Marketing" jane@example.com`,
+	Args:    cobra.MinimumNArgs(2),
+	RunE:    removeMembers,
+}
+
+func init() {
+	MembersCmd.AddCommand(MembersRemoveCmd)
+}
+
+func removeMembers(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := rootcmd.ResolveProject(ctx, client, args[0])
+	var members []n8n.User
+	if err == nil {
+		members, err = getMembers(ctx, client, *project.Id)
+	}
+
+	var toRemove []n8n.User
+	if err == nil {
+		for _, idOrEmail := range args[1:] {
+			member, ok := findMember(members, idOrEmail)
+			if !ok || member.Id == nil {
+				err = fmt.Errorf("user '%s' is not a member of project '%s'", idOrEmail, project.Name)
+				break
+			}
+			toRemove = append(toRemove, *member)
+		}
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error removing project members: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	for _, member := range toRemove {
+		if err := client.RemoveProjectMember(ctx, *project.Id, *member.Id); err != nil {
+			_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error removing %s from project '%s': %v\n", member.Email, project.Name, err)
+			if printErr != nil {
+				return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+			}
+			return err
+		}
+
+		if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Removed %s (ID: %s) from project '%s'\n", member.Email, *member.Id, project.Name); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	return nil
+}
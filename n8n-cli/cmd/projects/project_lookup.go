@@ -0,0 +1,36 @@
+// Package projects contains commands for the n8n-cli projects.
+package projects
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// projectRoles are the roles a user can have in a team project
+var projectRoles = []string{"project:admin", "project:editor", "project:viewer"}
+
+// parseProjectRole returns the project role for role, which may omit the "project:" prefix
+func parseProjectRole(role string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(role))
+	if !strings.HasPrefix(normalized, "project:") {
+		normalized = "project:" + normalized
+	}
+
+	for _, projectRole := range projectRoles {
+		if normalized == projectRole {
+			return projectRole, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid project role '%s': must be one of admin, editor or viewer", role)
+}
+
+// projectType returns the type of project, or N/A when the API did not report it
+func projectType(project n8n.Project) string {
+	if project.Type == nil {
+		return "N/A"
+	}
+	return *project.Type
+}
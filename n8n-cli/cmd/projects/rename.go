@@ -0,0 +1,70 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package projects
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// RenameCmd represents the rename project command
+var RenameCmd = &cobra.Command{
+	Use:     "rename PROJECT NEW_NAME",
+	Short:   "Rename a project",
+	Long:    `Rename a team project, identified by its ID or its current name.`,
+	Example: `  n8n projects rename "Marketing" "Marketing Automations"`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    renameProject,
+}
+
+func init() {
+	rootcmd.GetProjectsCmd().AddCommand(RenameCmd)
+}
+
+func renameProject(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	newName := args[1]
+	project, err := rootcmd.ResolveProject(ctx, client, args[0])
+	if err == nil {
+		err = client.UpdateProject(ctx, *project.Id, newName)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error renaming project: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Project '%s' (ID: %s) has been renamed to '%s'\n", project.Name, *project.Id, newName); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
@@ -22,8 +22,13 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/edenreich/n8n-cli/config"
 	"github.com/edenreich/n8n-cli/logger"
@@ -58,8 +63,20 @@ through version control systems.`,
 			return nil
 		}
 
-		if IsWorkflowCommand(cmd) && viper.GetString("api_key") == "" {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or N8N_API_KEY environment variable")
+		if !IsWorkflowCommand(cmd) {
+			return nil
+		}
+
+		if err := config.ValidateContext(viper.GetViper()); err != nil {
+			return err
+		}
+
+		apiKey, err := config.ResolveAPIKey(CommandContext(cmd), viper.GetViper())
+		if err != nil {
+			return err
+		}
+		if apiKey == "" {
+			return fmt.Errorf("API key is required. Set it using the --api-key flag, the N8N_API_KEY environment variable or api_key_command in the config file")
 		}
 		return nil
 	},
@@ -67,13 +84,39 @@ through version control systems.`,
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// The command context is cancelled on SIGINT or SIGTERM so long running commands
+// can stop cleanly between API calls.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
+
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
+
+		var exitErr *ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
 
+// ExitError is returned by commands that exit with a specific code, such as audit
+// when it finds risks, so that CI pipelines can tell them apart from other failures
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
 // GetRootCmd returns the root command for testing purposes
 func GetRootCmd() *cobra.Command {
 	return rootCmd
@@ -92,7 +135,17 @@ func init() {
 
 	rootCmd.PersistentFlags().StringP("api-key", "k", "", "n8n API Key (env: N8N_API_KEY)")
 	rootCmd.PersistentFlags().StringP("url", "u", "http://localhost:5678", "n8n instance URL (env: N8N_INSTANCE_URL)")
+	rootCmd.PersistentFlags().String("context", "", "Name of the context from the config file to use (env: N8N_CONTEXT)")
 	rootCmd.PersistentFlags().Bool("debug", false, "Enable debug logging (env: DEBUG)")
+	rootCmd.PersistentFlags().Duration("timeout", 60*time.Second, "Timeout for each API call, 0 disables it (env: N8N_TIMEOUT)")
+	rootCmd.PersistentFlags().Int("retry-max-attempts", 3, "Maximum attempts for API calls failing with transient errors, 1 disables retries (env: N8N_RETRY_MAX_ATTEMPTS)")
+	rootCmd.PersistentFlags().Duration("retry-backoff", 500*time.Millisecond, "Initial backoff between retries, doubled on every attempt (env: N8N_RETRY_BACKOFF)")
+	rootCmd.PersistentFlags().Bool("retry-non-idempotent", false, "Also retry POST and PATCH requests (env: N8N_RETRY_NON_IDEMPOTENT)")
+	rootCmd.PersistentFlags().String("ca-file", "", "PEM bundle of additional certificate authorities to trust (env: N8N_CA_FILE)")
+	rootCmd.PersistentFlags().String("client-cert", "", "PEM client certificate for mutual TLS (env: N8N_CLIENT_CERT)")
+	rootCmd.PersistentFlags().String("client-key", "", "PEM private key of the client certificate (env: N8N_CLIENT_KEY)")
+	rootCmd.PersistentFlags().Bool("insecure-skip-verify", false, "Disable TLS certificate verification, insecure (env: N8N_INSECURE_SKIP_VERIFY)")
+	rootCmd.PersistentFlags().String("proxy-url", "", "Proxy for all API calls, overrides HTTPS_PROXY (env: N8N_PROXY_URL)")
 	rootCmd.Flags().Bool("version", false, "Display the version information")
 
 	if err := viper.BindPFlag("api_key", rootCmd.PersistentFlags().Lookup("api-key")); err != nil {
@@ -101,21 +154,51 @@ func init() {
 	if err := viper.BindPFlag("instance_url", rootCmd.PersistentFlags().Lookup("url")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding url flag: %v\n", err)
 	}
+	if err := viper.BindPFlag(config.ContextKey, rootCmd.PersistentFlags().Lookup("context")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding context flag: %v\n", err)
+	}
 	if err := viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding debug flag: %v\n", err)
 	}
+	if err := viper.BindPFlag("timeout", rootCmd.PersistentFlags().Lookup("timeout")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding timeout flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("retry_max_attempts", rootCmd.PersistentFlags().Lookup("retry-max-attempts")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding retry-max-attempts flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("retry_backoff", rootCmd.PersistentFlags().Lookup("retry-backoff")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding retry-backoff flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("retry_non_idempotent", rootCmd.PersistentFlags().Lookup("retry-non-idempotent")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding retry-non-idempotent flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("ca_file", rootCmd.PersistentFlags().Lookup("ca-file")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding ca-file flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("client_cert", rootCmd.PersistentFlags().Lookup("client-cert")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding client-cert flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("client_key", rootCmd.PersistentFlags().Lookup("client-key")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding client-key flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("insecure_skip_verify", rootCmd.PersistentFlags().Lookup("insecure-skip-verify")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding insecure-skip-verify flag: %v\n", err)
+	}
+	if err := viper.BindPFlag("proxy_url", rootCmd.PersistentFlags().Lookup("proxy-url")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding proxy-url flag: %v\n", err)
+	}
 	rootCmd.Flags().BoolP("verbose", "V", false, "Show detailed output during synchronization")
 }
 
 // IsWorkflowCommand checks if the command or any of its parents is a command that requires API access
 func IsWorkflowCommand(cmd *cobra.Command) bool {
-	if cmd.Name() == "workflows" || cmd.Name() == "credentials" || cmd.Name() == "list" || cmd.Name() == "sync" || cmd.Name() == "activate" || cmd.Name() == "deactivate" || cmd.Name() == "refresh" || cmd.Name() == "executions" {
+	if cmd.Name() == "workflows" || cmd.Name() == "credentials" || cmd.Name() == "variables" || cmd.Name() == "projects" || cmd.Name() == "users" || cmd.Name() == "tags" || cmd.Name() == "audit" || cmd.Name() == "list" || cmd.Name() == "sync" || cmd.Name() == "activate" || cmd.Name() == "deactivate" || cmd.Name() == "refresh" || cmd.Name() == "executions" {
 		return true
 	}
 
 	parent := cmd.Parent()
 	for parent != nil {
-		if parent.Name() == "workflows" || parent.Name() == "credentials" {
+		if parent.Name() == "workflows" || parent.Name() == "credentials" || parent.Name() == "variables" || parent.Name() == "projects" || parent.Name() == "users" || parent.Name() == "tags" || parent.Name() == "executions" {
 			return true
 		}
 		parent = parent.Parent()
@@ -0,0 +1,93 @@
+// Package cmd contains commands for the n8n-cli
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// SyncSummary counts the changes made by a sync command
+type SyncSummary struct {
+	Created, Updated, Deleted, Unchanged, Failed int
+}
+
+// SyncApplier applies the changes of a sync command one at a time and counts them.
+// In dry-run mode the changes are only printed.
+type SyncApplier struct {
+	Summary SyncSummary
+
+	cmd    *cobra.Command
+	dryRun bool
+}
+
+// NewSyncApplier returns a SyncApplier that prints to the output of cmd
+func NewSyncApplier(cmd *cobra.Command, dryRun bool) *SyncApplier {
+	return &SyncApplier{cmd: cmd, dryRun: dryRun}
+}
+
+// Apply runs fn unless in dry-run mode and prints the message fn returns, empty
+// messages are not printed. The change
+// is counted in count, or in the counter fn returns when the change turned out to be
+// another one, such as an update that had to create the item again. A failed change
+// is reported and counted as failed, and the sync goes on with the next item; only
+// a cancelled command or an output error is returned.
+func (a *SyncApplier) Apply(count *int, dryRunMsg string, fn func() (*int, string, error)) error {
+	if a.dryRun {
+		*count++
+		return a.print(dryRunMsg)
+	}
+	if err := CommandContext(a.cmd).Err(); err != nil {
+		return err
+	}
+
+	counted, doneMsg, err := fn()
+	if err != nil {
+		a.Summary.Failed++
+		fmt.Fprintf(a.cmd.ErrOrStderr(), "Error: %v\n", err)
+		return nil
+	}
+	if counted != nil {
+		count = counted
+	}
+	*count++
+	return a.print(doneMsg)
+}
+
+// print writes a message to the standard output, unless it is empty
+func (a *SyncApplier) print(message string) error {
+	if message == "" {
+		return nil
+	}
+	return PrintLine(a.cmd, message)
+}
+
+// Finish prints the summary, such as "Variables: 1 created, 0 updated, 0 deleted,
+// 2 unchanged" for the kind "variable", and returns an error when a change failed
+func (a *SyncApplier) Finish(kind string) error {
+	verb := ""
+	if a.dryRun {
+		verb = "would be "
+	}
+	s := a.Summary
+	if err := PrintLine(a.cmd, fmt.Sprintf("%ss: %d %screated, %d %supdated, %d %sdeleted, %d unchanged",
+		strings.ToUpper(kind[:1])+kind[1:], s.Created, verb, s.Updated, verb, s.Deleted, verb, s.Unchanged)); err != nil {
+		return err
+	}
+
+	if s.Failed > 0 {
+		// The results are already printed, the usage would only obscure them
+		a.cmd.SilenceUsage = true
+		return fmt.Errorf("failed to sync %d %s(s)", s.Failed, kind)
+	}
+	return nil
+}
+
+// PrintLine writes a line to the standard output of cmd
+func PrintLine(cmd *cobra.Command, line string) error {
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), line); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package tags
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCmd represents the delete tag command
+var DeleteCmd = &cobra.Command{
+	Use:   "delete TAG",
+	Short: "Delete a tag by name or ID",
+	Long: `Delete a tag from your n8n instance by its name or ID. The tag is removed
+from every workflow that uses it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: deleteTag,
+}
+
+func init() {
+	rootcmd.GetTagsCmd().AddCommand(DeleteCmd)
+}
+
+func deleteTag(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := getTags(ctx, client)
+	var tag *n8n.Tag
+	var usage map[string]int
+	if err == nil {
+		tag, err = findTag(tags, args[0])
+	}
+	if err == nil {
+		usage, err = tagUsage(ctx, client)
+	}
+	if err == nil {
+		err = client.DeleteTag(ctx, *tag.Id)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting tag: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	message := fmt.Sprintf("Tag '%s' (ID: %s) has been deleted successfully\n", tag.Name, *tag.Id)
+	if count := usage[*tag.Id]; count > 0 {
+		message = fmt.Sprintf("Tag '%s' (ID: %s) has been deleted successfully and removed from %d workflow(s)\n", tag.Name, *tag.Id, count)
+	}
+	if _, err := fmt.Fprint(cmd.OutOrStdout(), message); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
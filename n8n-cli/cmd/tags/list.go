@@ -0,0 +1,129 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// tagRow is a tag with the number of workflows that use it
+type tagRow struct {
+	ID        string `json:"id" yaml:"id"`
+	Name      string `json:"name" yaml:"name"`
+	Workflows int    `json:"workflows" yaml:"workflows"`
+}
+
+// ListCmd represents the list tags command
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the tags of the n8n instance",
+	Long:  `List the tags of the n8n instance sorted by name, with the number of workflows that use each tag.`,
+	Args:  cobra.NoArgs,
+	RunE:  listTags,
+}
+
+func init() {
+	rootcmd.GetTagsCmd().AddCommand(ListCmd)
+
+	ListCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func listTags(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := getTags(ctx, client)
+	var usage map[string]int
+	if err == nil {
+		usage, err = tagUsage(ctx, client)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error listing tags: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	rows := make([]tagRow, 0, len(tags))
+	for _, tag := range tags {
+		row := tagRow{ID: "N/A", Name: tag.Name}
+		if tag.Id != nil {
+			row.ID = *tag.Id
+			row.Workflows = usage[*tag.Id]
+		}
+		rows = append(rows, row)
+	}
+
+	switch output {
+	case formatTable:
+		if len(rows) == 0 {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "No tags found")
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tNAME\tWORKFLOWS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, row := range rows {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%d\n", row.ID, row.Name, row.Workflows); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling tags to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(rows)
+		if err != nil {
+			return fmt.Errorf("error marshaling tags to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
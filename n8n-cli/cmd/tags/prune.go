@@ -0,0 +1,118 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package tags
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// PruneCmd represents the prune tags command
+var PruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete the tags no workflow uses",
+	Long: `Delete the tags that no workflow references. The usage is counted over the
+workflows the API key can see, so check the tags with --dry-run first when the key
+does not have access to every project.`,
+	Example: `  n8n tags prune --dry-run
+  n8n tags prune`,
+	Args: cobra.NoArgs,
+	RunE: pruneTags,
+}
+
+func init() {
+	rootcmd.GetTagsCmd().AddCommand(PruneCmd)
+
+	PruneCmd.Flags().Bool("dry-run", false, "Show the tags that would be deleted without deleting them")
+}
+
+func pruneTags(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := getTags(ctx, client)
+	var usage map[string]int
+	if err == nil {
+		usage, err = tagUsage(ctx, client)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error pruning tags: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	deleted, inUse, failed := 0, 0, 0
+	for _, tag := range tags {
+		if tag.Id == nil {
+			continue
+		}
+		if usage[*tag.Id] > 0 {
+			inUse++
+			continue
+		}
+
+		if dryRun {
+			deleted++
+			if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Would delete unused tag '%s' (ID: %s)\n", tag.Name, *tag.Id); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+			continue
+		}
+
+		if err := client.DeleteTag(ctx, *tag.Id); err != nil {
+			failed++
+			if _, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting tag '%s' (ID: %s): %v\n", tag.Name, *tag.Id, err); printErr != nil {
+				return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+			}
+			continue
+		}
+		deleted++
+		if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Deleted unused tag '%s' (ID: %s)\n", tag.Name, *tag.Id); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	summary := fmt.Sprintf("Tags: %d deleted, %d in use\n", deleted, inUse)
+	if dryRun {
+		summary = fmt.Sprintf("Tags: %d would be deleted, %d in use\n", deleted, inUse)
+	}
+	if _, err := fmt.Fprint(cmd.OutOrStdout(), summary); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	if failed > 0 {
+		// The results are already printed, the usage would only obscure them
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to delete %d tag(s)", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package tags
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// RenameCmd represents the rename tag command
+var RenameCmd = &cobra.Command{
+	Use:     "rename TAG NEW_NAME",
+	Short:   "Rename a tag",
+	Long:    `Rename a tag, identified by its current name or its ID. The workflows that use the tag keep it under the new name.`,
+	Example: `  n8n tags rename "prod" "production"`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    renameTag,
+}
+
+func init() {
+	rootcmd.GetTagsCmd().AddCommand(RenameCmd)
+}
+
+func renameTag(cmd *cobra.Command, args []string) error {
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	newName := args[1]
+	tags, err := getTags(ctx, client)
+	var tag *n8n.Tag
+	if err == nil {
+		tag, err = findTag(tags, args[0])
+	}
+	if err == nil {
+		_, err = client.UpdateTag(ctx, *tag.Id, newName)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error renaming tag: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Tag '%s' (ID: %s) has been renamed to '%s'\n", tag.Name, *tag.Id, newName); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Package tags contains commands for the n8n-cli tags.
+package tags
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// getTags fetches the tags of the instance sorted by name
+func getTags(ctx context.Context, client n8n.ClientInterface) ([]n8n.Tag, error) {
+	tagList, err := client.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching tags: %w", err)
+	}
+
+	tags := []n8n.Tag{}
+	if tagList != nil && tagList.Data != nil {
+		tags = *tagList.Data
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
+// findTag finds a tag by its name, or by its ID when no tag has that name.
+// The returned error matches n8n.ErrNotFound when there is no such tag.
+func findTag(tags []n8n.Tag, nameOrID string) (*n8n.Tag, error) {
+	for _, tag := range tags {
+		if tag.Name == nameOrID && tag.Id != nil {
+			return &tag, nil
+		}
+	}
+
+	for _, tag := range tags {
+		if tag.Id != nil && *tag.Id == nameOrID {
+			return &tag, nil
+		}
+	}
+
+	return nil, fmt.Errorf("tag '%s' %w", nameOrID, n8n.ErrNotFound)
+}
+
+// tagUsage counts the workflows that reference each tag, by tag ID
+func tagUsage(ctx context.Context, client n8n.ClientInterface) (map[string]int, error) {
+	workflowList, err := client.GetWorkflows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching workflows: %w", err)
+	}
+
+	usage := make(map[string]int)
+	if workflowList == nil || workflowList.Data == nil {
+		return usage, nil
+	}
+	for _, workflow := range *workflowList.Data {
+		if workflow.Tags == nil {
+			continue
+		}
+		for _, tag := range *workflow.Tags {
+			if tag.Id != nil {
+				usage[*tag.Id]++
+			}
+		}
+	}
+
+	return usage, nil
+}
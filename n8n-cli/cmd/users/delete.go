@@ -0,0 +1,115 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package users
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCmd represents the delete user command
+var DeleteCmd = &cobra.Command{
+	Use:   "delete USER",
+	Short: "Delete a user by ID or email address",
+	Long: `Delete a user from your n8n instance by their ID or email address.
+
+The workflows and credentials of the user are deleted with them unless
+--transfer-to names a project, by ID or name, to move them to. To hand them
+over to another user, use the personal project of that user shown by
+"n8n projects list".`,
+	Example: `  n8n users delete jane@example.com --transfer-to "Marketing" --dry-run`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    deleteUser,
+}
+
+func init() {
+	rootcmd.GetUsersCmd().AddCommand(DeleteCmd)
+
+	DeleteCmd.Flags().String("transfer-to", "", "Project (ID or name) that receives the workflows and credentials of the user")
+	DeleteCmd.Flags().Bool("dry-run", false, "Show the user that would be deleted without deleting them")
+	DeleteCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func deleteUser(cmd *cobra.Command, args []string) error {
+	transferTo, _ := cmd.Flags().GetString("transfer-to")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	output, _ := cmd.Flags().GetString("output")
+
+	if err := validateOutput(output); err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	users, err := getUsers(ctx, client)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting user: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	user, err := findUser(users, args[0])
+	result := userResult{DryRun: dryRun}
+	transferProjectID := ""
+	if err == nil {
+		result.ID, result.Email, result.Role = *user.Id, string(user.Email), userRole(*user)
+		if transferTo != "" {
+			project, projectErr := rootcmd.ResolveProject(ctx, client, transferTo)
+			if projectErr != nil {
+				err = fmt.Errorf("error resolving --transfer-to: %w", projectErr)
+			} else {
+				transferProjectID = *project.Id
+				result.TransferTo = project.Name
+			}
+		}
+	}
+	if err == nil && !dryRun {
+		err = client.DeleteUser(ctx, *user.Id, transferProjectID)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting user: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	result.Status = "deleted"
+	if dryRun {
+		result.Status = "would be deleted"
+	}
+	if result.TransferTo != "" {
+		result.Status = fmt.Sprintf("%s, data moved to '%s'", result.Status, result.TransferTo)
+	} else if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the workflows and credentials of %s are deleted with the user, use --transfer-to to keep them\n", result.Email); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return printResults(cmd, output, []userResult{result})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package users
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// InviteCmd represents the invite users command
+var InviteCmd = &cobra.Command{
+	Use:   "invite",
+	Short: "Invite users to the n8n instance",
+	Long: `Invite one or more users to the n8n instance, given with --email or read
+from a CSV file with --file. The CSV file has an email column and an optional
+role column; users without a role get the role given by --role. Users that
+already exist, including those with a pending invitation, are skipped.`,
+	Example: `  n8n users invite --email jane@example.com --role admin
+  n8n users invite --file users.csv --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: inviteUsers,
+}
+
+func init() {
+	rootcmd.GetUsersCmd().AddCommand(InviteCmd)
+
+	InviteCmd.Flags().StringSlice("email", nil, "Email address of a user to invite (can be repeated)")
+	InviteCmd.Flags().StringP("file", "f", "", "CSV file with the columns email and role")
+	InviteCmd.Flags().String("role", "member", "Role of the invited users: admin or member")
+	InviteCmd.Flags().Bool("dry-run", false, "Show the users that would be invited without inviting them")
+	InviteCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func inviteUsers(cmd *cobra.Command, args []string) error {
+	emails, _ := cmd.Flags().GetStringSlice("email")
+	file, _ := cmd.Flags().GetString("file")
+	roleFlag, _ := cmd.Flags().GetString("role")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	output, _ := cmd.Flags().GetString("output")
+
+	if err := validateOutput(output); err != nil {
+		return err
+	}
+	role, err := parseGlobalRole(roleFlag)
+	if err != nil {
+		return err
+	}
+
+	var invites []n8n.UserInvite
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		if err := validateEmail(email); err != nil {
+			return err
+		}
+		invites = append(invites, n8n.UserInvite{Email: email, Role: role})
+	}
+	if file != "" {
+		fileInvites, err := readInvitesFile(file, role)
+		if err != nil {
+			return err
+		}
+		invites = append(invites, fileInvites...)
+	}
+	if len(invites) == 0 {
+		return fmt.Errorf("no users to invite: use --email or --file")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	users, err := getUsers(ctx, client)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error inviting users: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	results := make([]userResult, 0, len(invites))
+	var pending []n8n.UserInvite
+	seen := make(map[string]bool)
+	for _, invite := range invites {
+		email := strings.ToLower(invite.Email)
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		result := userResult{Email: invite.Email, Role: invite.Role, DryRun: dryRun}
+		if user, err := findUser(users, invite.Email); err == nil {
+			result.ID = *user.Id
+			result.Role = userRole(*user)
+			result.Status = "exists"
+		} else if dryRun {
+			result.Status = "would invite"
+		} else {
+			pending = append(pending, invite)
+		}
+		results = append(results, result)
+	}
+
+	failed := 0
+	if len(pending) > 0 {
+		invitations, err := client.InviteUsers(ctx, pending)
+		byEmail := make(map[string]n8n.UserInvitation)
+		for _, invitation := range invitations {
+			if invitation.User != nil {
+				byEmail[strings.ToLower(invitation.User.Email)] = invitation
+			}
+		}
+
+		for i := range results {
+			if results[i].Status != "" {
+				continue
+			}
+
+			invitation, ok := byEmail[strings.ToLower(results[i].Email)]
+			switch {
+			case err != nil:
+				results[i].Error = err.Error()
+			case !ok:
+				results[i].Error = "the instance did not report the invitation"
+			case invitation.Error != "":
+				results[i].Error = invitation.Error
+			}
+
+			if results[i].Error != "" {
+				failed++
+				results[i].Status = "failed"
+				if _, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error inviting %s: %s\n", results[i].Email, results[i].Error); printErr != nil {
+					return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, results[i].Error)
+				}
+				continue
+			}
+
+			results[i].ID = invitation.User.Id
+			results[i].InviteAcceptURL = invitation.User.InviteAcceptUrl
+			results[i].Status = "invited"
+		}
+	}
+
+	if err := printResults(cmd, output, results); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		// The results are already printed, the usage would only obscure them
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to invite %d user(s)", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package users
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// validateEmail returns an error when email is not a plain email address
+func validateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return fmt.Errorf("invalid email address '%s'", email)
+	}
+
+	return nil
+}
+
+// readInvitesFile reads the users to invite from a CSV file with an email column
+// and an optional role column. A header row is skipped when its first column is
+// "email". Users without a role get defaultRole.
+func readInvitesFile(path string, defaultRole string) ([]n8n.UserInvite, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening invite file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var invites []n8n.UserInvite
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading invite file %s: %w", path, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		email := strings.TrimSpace(record[0])
+		if len(invites) == 0 && strings.EqualFold(email, "email") {
+			continue
+		}
+		if len(record) > 2 {
+			return nil, fmt.Errorf("%s line %d: expected the columns email and role, got %d columns", path, line, len(record))
+		}
+		if err := validateEmail(email); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+
+		role := defaultRole
+		if len(record) == 2 && strings.TrimSpace(record[1]) != "" {
+			role, err = parseGlobalRole(record[1])
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+			}
+		}
+
+		invites = append(invites, n8n.UserInvite{Email: email, Role: role})
+	}
+
+	return invites, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// ListCmd represents the list users command
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the users of the n8n instance",
+	Long: `List the users of the n8n instance, sorted by email address. Users who
+have not accepted their invitation yet are shown with the pending status.`,
+	Args: cobra.NoArgs,
+	RunE: listUsers,
+}
+
+func init() {
+	rootcmd.GetUsersCmd().AddCommand(ListCmd)
+
+	ListCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+	ListCmd.Flags().Bool("pending", false, "Only list users with a pending invitation")
+}
+
+func listUsers(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	pendingOnly, _ := cmd.Flags().GetBool("pending")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	allUsers, err := getUsers(ctx, client)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching users: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	users := []n8n.User{}
+	for _, user := range allUsers {
+		if !pendingOnly || userStatus(user) == "pending" {
+			users = append(users, user)
+		}
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].Email < users[j].Email
+	})
+
+	switch output {
+	case formatTable:
+		if len(users) == 0 {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "No users found")
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tEMAIL\tNAME\tROLE\tSTATUS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, user := range users {
+			id := "N/A"
+			if user.Id != nil {
+				id = *user.Id
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", id, user.Email, userName(user), userRole(user), userStatus(user)); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(users, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling users to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(users)
+		if err != nil {
+			return fmt.Errorf("error marshaling users to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
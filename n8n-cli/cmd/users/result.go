@@ -0,0 +1,77 @@
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// userResult is the outcome of a change to one user, printed by invite, set-role and delete
+type userResult struct {
+	ID              string `json:"id,omitempty" yaml:"id,omitempty"`
+	Email           string `json:"email" yaml:"email"`
+	Role            string `json:"role,omitempty" yaml:"role,omitempty"`
+	Status          string `json:"status" yaml:"status"`
+	DryRun          bool   `json:"dryRun" yaml:"dryRun"`
+	InviteAcceptURL string `json:"inviteAcceptUrl,omitempty" yaml:"inviteAcceptUrl,omitempty"`
+	TransferTo      string `json:"transferTo,omitempty" yaml:"transferTo,omitempty"`
+	Error           string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// printResults prints the results in the given output format
+func printResults(cmd *cobra.Command, output string, results []userResult) error {
+	switch output {
+	case formatTable:
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tEMAIL\tROLE\tSTATUS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, result := range results {
+			id := result.ID
+			if id == "" {
+				id = "N/A"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", id, result.Email, result.Role, result.Status); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling results to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("error marshaling results to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
+
+// validateOutput returns an error for an unsupported output format, so that
+// commands can reject it before changing anything
+func validateOutput(output string) error {
+	switch output {
+	case formatTable, formatJSON, formatYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package users
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+)
+
+// SetRoleCmd represents the set-role command
+var SetRoleCmd = &cobra.Command{
+	Use:     "set-role USER ROLE",
+	Short:   "Change the role of a user",
+	Long:    `Change the instance role of a user, identified by their ID or email address, to admin or member.`,
+	Example: `  n8n users set-role jane@example.com admin`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    setRole,
+}
+
+func init() {
+	rootcmd.GetUsersCmd().AddCommand(SetRoleCmd)
+
+	SetRoleCmd.Flags().Bool("dry-run", false, "Show the change without applying it")
+	SetRoleCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func setRole(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	output, _ := cmd.Flags().GetString("output")
+
+	if err := validateOutput(output); err != nil {
+		return err
+	}
+	role, err := parseGlobalRole(args[1])
+	if err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	users, err := getUsers(ctx, client)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error changing user role: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	user, err := findUser(users, args[0])
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error changing user role: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	result := userResult{ID: *user.Id, Email: string(user.Email), Role: role, DryRun: dryRun}
+	switch {
+	case userRole(*user) == role:
+		result.Status = "unchanged"
+	case dryRun:
+		result.Status = fmt.Sprintf("would change from %s", userRole(*user))
+	default:
+		if err := client.ChangeUserRole(ctx, *user.Id, role); err != nil {
+			_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error changing user role: %v\n", err)
+			if printErr != nil {
+				return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+			}
+			return err
+		}
+		result.Status = fmt.Sprintf("changed from %s", userRole(*user))
+	}
+
+	return printResults(cmd, output, []userResult{result})
+}
@@ -0,0 +1,88 @@
+// Package users contains commands for the n8n-cli users.
+package users
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// globalRoles are the instance roles that can be assigned to a user. The owner
+// role cannot be assigned.
+var globalRoles = []string{"global:admin", "global:member"}
+
+// parseGlobalRole returns the global role for role, which may omit the "global:" prefix
+func parseGlobalRole(role string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(role))
+	if !strings.HasPrefix(normalized, "global:") {
+		normalized = "global:" + normalized
+	}
+
+	for _, globalRole := range globalRoles {
+		if normalized == globalRole {
+			return globalRole, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid role '%s': must be admin or member", role)
+}
+
+// getUsers fetches all users of the instance
+func getUsers(ctx context.Context, client n8n.ClientInterface) ([]n8n.User, error) {
+	userList, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching users: %w", err)
+	}
+
+	if userList == nil || userList.Data == nil {
+		return []n8n.User{}, nil
+	}
+	return *userList.Data, nil
+}
+
+// findUser returns the user with the given ID or email address. Email addresses
+// are compared case-insensitively. The returned error matches n8n.ErrNotFound
+// when there is no such user.
+func findUser(users []n8n.User, idOrEmail string) (*n8n.User, error) {
+	for _, user := range users {
+		if (user.Id != nil && *user.Id == idOrEmail) || strings.EqualFold(string(user.Email), idOrEmail) {
+			if user.Id == nil {
+				return nil, fmt.Errorf("user '%s' has no ID", idOrEmail)
+			}
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user '%s' %w", idOrEmail, n8n.ErrNotFound)
+}
+
+// userRole returns the global role of a user, or N/A when the API did not report it
+func userRole(user n8n.User) string {
+	if user.Role == nil || *user.Role == "" {
+		return "N/A"
+	}
+	return *user.Role
+}
+
+// userStatus returns whether the user has accepted their invitation
+func userStatus(user n8n.User) string {
+	if user.IsPending != nil && *user.IsPending {
+		return "pending"
+	}
+	return "active"
+}
+
+// userName returns the full name of a user, or an empty string when it is not set
+func userName(user n8n.User) string {
+	var parts []string
+	if user.FirstName != nil && *user.FirstName != "" {
+		parts = append(parts, *user.FirstName)
+	}
+	if user.LastName != nil && *user.LastName != "" {
+		parts = append(parts, *user.LastName)
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -2,26 +2,28 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 	"unicode"
 
 	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
 )
 
-// FormatAPIBaseURL ensures the base URL ends with /api/v1
-func FormatAPIBaseURL(instanceURL string) string {
-	instanceURL = strings.TrimSuffix(instanceURL, "/")
-
-	if !strings.HasSuffix(instanceURL, "/api/v1") {
-		instanceURL = instanceURL + "/api/v1"
+// CommandContext returns the context of the running command, falling back to
+// context.Background when the command was executed without one
+func CommandContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
 	}
 
-	return instanceURL
+	return context.Background()
 }
 
-// FindWorkflow looks up a workflow by exact name match in a list of workflows
+// FindWorkflow looks up a workflow by exact name match in a list of workflows.
+// The returned error matches n8n.ErrNotFound when no workflow has the given name.
 func FindWorkflow(name string, workflows []n8n.Workflow) (string, error) {
 	for _, wf := range workflows {
 		if wf.Name == name {
@@ -29,7 +31,45 @@ func FindWorkflow(name string, workflows []n8n.Workflow) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("workflow with name '%s' not found", name)
+	return "", fmt.Errorf("workflow with name '%s' %w", name, n8n.ErrNotFound)
+}
+
+// ResolveProject finds a project by its ID, or by its name when no project has that ID.
+// The returned error matches n8n.ErrNotFound when there is no such project.
+func ResolveProject(ctx context.Context, client n8n.ClientInterface, nameOrID string) (*n8n.Project, error) {
+	projectList, err := client.GetProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching projects: %w", err)
+	}
+
+	if projectList == nil || projectList.Data == nil {
+		return nil, fmt.Errorf("project '%s' %w", nameOrID, n8n.ErrNotFound)
+	}
+
+	for _, project := range *projectList.Data {
+		if project.Id != nil && *project.Id == nameOrID {
+			return &project, nil
+		}
+	}
+
+	var matches []n8n.Project
+	for _, project := range *projectList.Data {
+		if project.Name == nameOrID {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("project '%s' %w", nameOrID, n8n.ErrNotFound)
+	case 1:
+		if matches[0].Id == nil {
+			return nil, fmt.Errorf("project '%s' has no ID", nameOrID)
+		}
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d projects are named '%s', use the project ID instead", len(matches), nameOrID)
+	}
 }
 
 // SanitizeFilename converts a workflow name to a valid filename
@@ -0,0 +1,72 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package variables
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// CreateCmd represents the create variable command
+var CreateCmd = &cobra.Command{
+	Use:   "create KEY VALUE",
+	Short: "Create a variable",
+	Long: `Create a variable in the n8n instance. Workflows read it as $vars.KEY.
+Keys may only contain letters, digits and underscores.`,
+	Example: `  n8n variables create API_BASE_URL https://api.example.com`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    createVariable,
+}
+
+func init() {
+	rootcmd.GetVariablesCmd().AddCommand(CreateCmd)
+}
+
+func createVariable(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+	if err := validateVariableKey(key); err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateVariable(ctx, &n8n.Variable{Key: key, Value: value}); err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error creating variable: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Variable '%s' has been created successfully\n", key); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output format constants
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// ListCmd represents the list variables command
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the variables of the n8n instance",
+	Long:  `List the variables of the n8n instance, sorted by key.`,
+	Args:  cobra.NoArgs,
+	RunE:  listVariables,
+}
+
+func init() {
+	rootcmd.GetVariablesCmd().AddCommand(ListCmd)
+
+	ListCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func listVariables(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	variableList, err := client.GetVariables(ctx)
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching variables: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	variables := []n8n.Variable{}
+	if variableList != nil && variableList.Data != nil {
+		variables = *variableList.Data
+	}
+	sort.SliceStable(variables, func(i, j int) bool {
+		return variables[i].Key < variables[j].Key
+	})
+
+	switch output {
+	case formatTable:
+		if len(variables) == 0 {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "No variables found")
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tKEY\tVALUE"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, variable := range variables {
+			id := "N/A"
+			if variable.Id != nil {
+				id = *variable.Id
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", id, variable.Key, variable.Value); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(variables, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling variables to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(variables)
+		if err != nil {
+			return fmt.Errorf("error marshaling variables to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package variables
+
+import (
+	"fmt"
+	"sort"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// SyncCmd represents the sync variables command
+var SyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Reconcile the variables of the n8n instance with a file",
+	Long: `Reconcile the variables of the n8n instance with a YAML or JSON file, so the
+$vars of each environment can be kept in version control next to the workflows.
+
+Variables missing on the instance are created and variables with a different
+value are updated. With --prune, variables that are not in the file are deleted.
+Values are never printed.
+
+The file maps variable keys to their values:
+
+  variables:
+    API_BASE_URL: https://api.example.com
+    RETRY_COUNT: "3"`,
+	Example: `  # Preview the changes
+  n8n variables sync -f variables/production.yaml --dry-run
+
+  # Apply them and delete the variables that are not in the file
+  n8n variables sync -f variables/production.yaml --prune`,
+	Args: cobra.NoArgs,
+	RunE: syncVariables,
+}
+
+func init() {
+	rootcmd.GetVariablesCmd().AddCommand(SyncCmd)
+
+	SyncCmd.Flags().StringP("file", "f", "", "Variables file (YAML or JSON)")
+	SyncCmd.Flags().Bool("dry-run", false, "Show what would change without making changes")
+	SyncCmd.Flags().Bool("prune", false, "Delete variables that are not present in the file")
+
+	_ = SyncCmd.MarkFlagRequired("file")
+	// nolint:errcheck
+	SyncCmd.MarkFlagFilename("file", "yaml", "yml", "json")
+}
+
+func syncVariables(cmd *cobra.Command, args []string) error {
+	filePath, _ := cmd.Flags().GetString("file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	prune, _ := cmd.Flags().GetBool("prune")
+
+	keys, values, err := readVariablesFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	variableList, err := client.GetVariables(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching variables: %w", err)
+	}
+
+	remote := make(map[string]n8n.Variable)
+	var remoteKeys []string
+	if variableList != nil && variableList.Data != nil {
+		for _, variable := range *variableList.Data {
+			if _, exists := remote[variable.Key]; !exists {
+				remote[variable.Key] = variable
+				remoteKeys = append(remoteKeys, variable.Key)
+			}
+		}
+	}
+	sort.Strings(remoteKeys)
+
+	applier := rootcmd.NewSyncApplier(cmd, dryRun)
+	summary := &applier.Summary
+
+	for _, key := range keys {
+		value := values[key]
+		existing, exists := remote[key]
+
+		switch {
+		case !exists:
+			err = applier.Apply(&summary.Created,
+				fmt.Sprintf("Would create variable '%s'", key),
+				func() (*int, string, error) {
+					if err := client.CreateVariable(ctx, &n8n.Variable{Key: key, Value: value}); err != nil {
+						return nil, "", fmt.Errorf("error creating variable '%s': %w", key, err)
+					}
+					return nil, fmt.Sprintf("Created variable '%s'", key), nil
+				})
+		case existing.Value != value && existing.Id != nil:
+			id := *existing.Id
+			err = applier.Apply(&summary.Updated,
+				fmt.Sprintf("Would update variable '%s' (ID: %s)", key, id),
+				func() (*int, string, error) {
+					if err := client.UpdateVariable(ctx, id, &n8n.Variable{Key: key, Value: value}); err != nil {
+						return nil, "", fmt.Errorf("error updating variable '%s' (ID: %s): %w", key, id, err)
+					}
+					return nil, fmt.Sprintf("Updated variable '%s' (ID: %s)", key, id), nil
+				})
+		default:
+			summary.Unchanged++
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if prune {
+		for _, key := range remoteKeys {
+			existing := remote[key]
+			if _, keep := values[key]; keep || existing.Id == nil {
+				continue
+			}
+
+			id := *existing.Id
+			err = applier.Apply(&summary.Deleted,
+				fmt.Sprintf("Would delete variable '%s' (ID: %s) that is not in the file", key, id),
+				func() (*int, string, error) {
+					if err := client.DeleteVariable(ctx, id); err != nil {
+						return nil, "", fmt.Errorf("error deleting variable '%s' (ID: %s): %w", key, id, err)
+					}
+					return nil, fmt.Sprintf("Deleted variable '%s' (ID: %s) that is not in the file", key, id), nil
+				})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return applier.Finish("variable")
+}
@@ -0,0 +1,94 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package variables
+
+import (
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// UpdateCmd represents the update variable command
+var UpdateCmd = &cobra.Command{
+	Use:   "update KEY|ID VALUE",
+	Short: "Update the value of a variable",
+	Long: `Update the value of a variable, found by its key or ID. Use --key to rename
+the variable at the same time.`,
+	Example: `  n8n variables update API_BASE_URL https://api.staging.example.com
+  n8n variables update API_BASE_URL https://api.example.com --key API_URL`,
+	Args: cobra.ExactArgs(2),
+	RunE: updateVariable,
+}
+
+func init() {
+	rootcmd.GetVariablesCmd().AddCommand(UpdateCmd)
+
+	UpdateCmd.Flags().String("key", "", "New key of the variable")
+}
+
+func updateVariable(cmd *cobra.Command, args []string) error {
+	newKey, _ := cmd.Flags().GetString("key")
+	if newKey != "" {
+		if err := validateVariableKey(newKey); err != nil {
+			return err
+		}
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	variable, err := resolveVariable(ctx, client, args[0])
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error updating variable: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+	if variable.Id == nil {
+		return fmt.Errorf("variable '%s' has no ID", variable.Key)
+	}
+
+	updated := n8n.Variable{Key: variable.Key, Value: args[1]}
+	if newKey != "" {
+		updated.Key = newKey
+	}
+
+	if err := client.UpdateVariable(ctx, *variable.Id, &updated); err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error updating variable: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Variable '%s' (ID: %s) has been updated successfully\n", updated.Key, *variable.Id); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	return nil
+}
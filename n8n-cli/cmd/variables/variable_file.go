@@ -0,0 +1,61 @@
+// Package variables contains commands for the n8n-cli variables.
+package variables
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// variablesFile is the file reconciled by the sync command, in YAML or JSON:
+//
+//	variables:
+//	  API_BASE_URL: https://api.example.com
+//	  RETRY_COUNT: "3"
+type variablesFile struct {
+	Variables yaml.Node `yaml:"variables"`
+}
+
+// readVariablesFile reads the variables of a variables file by key, in the order they are defined.
+// Values are taken literally, so RETRY_COUNT: 3.0 yields "3.0".
+func readVariablesFile(path string) ([]string, map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading variables file: %w", err)
+	}
+
+	var file variablesFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, nil, fmt.Errorf("error parsing variables file %s: %w", path, err)
+	}
+
+	if file.Variables.Kind == 0 {
+		return nil, nil, fmt.Errorf("variables file %s has no 'variables' section", path)
+	}
+	if file.Variables.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("'variables' in %s must be a mapping of keys to values", path)
+	}
+
+	var keys []string
+	values := make(map[string]string)
+	for i := 0; i+1 < len(file.Variables.Content); i += 2 {
+		key := file.Variables.Content[i].Value
+		value := file.Variables.Content[i+1]
+
+		if err := validateVariableKey(key); err != nil {
+			return nil, nil, fmt.Errorf("%s line %d: %w", path, file.Variables.Content[i].Line, err)
+		}
+		if _, exists := values[key]; exists {
+			return nil, nil, fmt.Errorf("%s line %d: variable '%s' is defined more than once", path, file.Variables.Content[i].Line, key)
+		}
+		if value.Kind != yaml.ScalarNode || value.Tag == "!!null" {
+			return nil, nil, fmt.Errorf("%s line %d: variable '%s' must have a string value", path, value.Line, key)
+		}
+
+		keys = append(keys, key)
+		values[key] = value.Value
+	}
+
+	return keys, values, nil
+}
@@ -0,0 +1,49 @@
+// Package variables contains commands for the n8n-cli variables.
+package variables
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// variableKeyPattern matches the keys n8n accepts for variables
+var variableKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// validateVariableKey returns an error when n8n would reject key
+func validateVariableKey(key string) error {
+	if !variableKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid variable key '%s': only letters, digits and underscores are allowed", key)
+	}
+
+	return nil
+}
+
+// resolveVariable finds a variable by its key, or by its ID when no variable has that key.
+// The returned error matches n8n.ErrNotFound when there is no such variable.
+func resolveVariable(ctx context.Context, client n8n.ClientInterface, keyOrID string) (*n8n.Variable, error) {
+	variableList, err := client.GetVariables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching variables: %w", err)
+	}
+
+	if variableList == nil || variableList.Data == nil {
+		return nil, fmt.Errorf("variable '%s' %w", keyOrID, n8n.ErrNotFound)
+	}
+
+	for _, variable := range *variableList.Data {
+		if variable.Key == keyOrID {
+			return &variable, nil
+		}
+	}
+
+	for _, variable := range *variableList.Data {
+		if variable.Id != nil && *variable.Id == keyOrID {
+			return &variable, nil
+		}
+	}
+
+	return nil, fmt.Errorf("variable '%s' %w", keyOrID, n8n.ErrNotFound)
+}
@@ -29,33 +29,8 @@ import (
 var workflowsCmd = &cobra.Command{
 	Use:   "workflows",
 	Short: "Manage n8n workflows",
-	Long: `The workflows command provides utilities to import, export, list, and 
-synchronize n8n workflows between your local filesystem and n8n instances.
-
-Usage:
-  n8n workflows [flags]
-  n8n workflows [command]
-
-Available Commands:
-  activate    Activate a workflow by ID
-  deactivate  Deactivate a workflow by ID
-  delete      Delete a workflow by ID
-  executions  Get execution history for workflows
-  list        List JSON workflows in n8n instance
-  pull        Pull a workflow from n8n into a local file
-  push        Push a local workflow file to n8n
-  refresh     Refresh the state of workflows in the directory from n8n instance
-  sync        Synchronize workflows between local files and n8n instance
-
-Flags:
-  -h, --help   help for workflows
-
-Global Flags:
-  -k, --api-key string   n8n API Key (env: N8N_API_KEY)
-      --debug            Enable debug logging (env: DEBUG)
-  -u, --url string       n8n instance URL (env: N8N_INSTANCE_URL) (default "http://localhost:5678")
-
-Use "n8n workflows [command] --help" for more information about a command.`,
+	Long: `The workflows command provides utilities to import, export, list, and
+synchronize n8n workflows between your local filesystem and n8n instances.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmd.Help()
 	},
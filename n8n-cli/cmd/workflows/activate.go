@@ -28,7 +28,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // ActivateCommand represents the command to activate a workflow
@@ -57,13 +56,14 @@ func activateWorkflow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("this command requires a workflow ID")
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
-
-	client := n8n.NewClient(instanceURL, apiKey)
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
 	workflowID := args[0]
-	workflow, err := client.ActivateWorkflow(workflowID)
+	workflow, err := client.ActivateWorkflow(ctx, workflowID)
 	if err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error activating workflow: %v\n", err)
 		if printErr != nil {
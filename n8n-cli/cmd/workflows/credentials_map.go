@@ -0,0 +1,145 @@
+package workflows
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// credentialsMap maps the credentials used by nodes, by credential type and name, to
+// the IDs of the credentials on one instance. The workflow files only keep the names,
+// and every instance has its own map:
+//
+//	credentials:
+//	  slackApi:
+//	    Slack Alerts: "42"
+//	  postgres:
+//	    Production DB: "7"
+type credentialsMap struct {
+	Credentials map[string]map[string]string `yaml:"credentials"`
+}
+
+// loadCredentialsMap reads the file given with --credentials-map, it returns nil when
+// the flag is not set. Commands load it once before touching any workflow, so a
+// malformed file fails the command before anything changes.
+func loadCredentialsMap(cmd *cobra.Command) (*credentialsMap, error) {
+	path, _ := cmd.Flags().GetString("credentials-map")
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials map: %w", err)
+	}
+
+	var credentials credentialsMap
+	if err := yaml.Unmarshal(content, &credentials); err != nil {
+		return nil, fmt.Errorf("error parsing credentials map %s: %w", path, err)
+	}
+	if credentials.Credentials == nil {
+		return nil, fmt.Errorf("credentials map %s has no 'credentials' section", path)
+	}
+
+	return &credentials, nil
+}
+
+// resolve sets the IDs of the node credentials that are in the map, before a workflow
+// is sent to the instance. It returns a warning for every credential without an ID
+// that is not in the map. A nil map leaves the workflow unchanged.
+func (m *credentialsMap) resolve(workflow *n8n.Workflow) []string {
+	if m == nil {
+		return nil
+	}
+
+	var warnings []string
+	m.rewrite(workflow, func(node n8n.Node, credentialType string, credential map[string]interface{}) {
+		name, _ := credential["name"].(string)
+		if id, ok := m.Credentials[credentialType][name]; ok {
+			credential["id"] = id
+			return
+		}
+		if id, _ := credential["id"].(string); id == "" {
+			warnings = append(warnings, fmt.Sprintf("credential '%s' (%s) of node '%s' is not in the credentials map", name, credentialType, nodeName(node)))
+		}
+	})
+	return warnings
+}
+
+// neutralize replaces the node credentials that are in the map with their name, before
+// a workflow is written to a file, so the file can be synced to any instance. A nil
+// map leaves the workflow unchanged.
+func (m *credentialsMap) neutralize(workflow *n8n.Workflow) {
+	if m == nil {
+		return
+	}
+
+	m.rewrite(workflow, func(_ n8n.Node, credentialType string, credential map[string]interface{}) {
+		id, _ := credential["id"].(string)
+		if id == "" {
+			return
+		}
+		names := make([]string, 0, len(m.Credentials[credentialType]))
+		for name := range m.Credentials[credentialType] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if m.Credentials[credentialType][name] == id {
+				delete(credential, "id")
+				credential["name"] = name
+				return
+			}
+		}
+	})
+}
+
+// rewrite calls fn for every credential of the nodes of workflow in a stable order.
+// The nodes and their credentials are copied first, so workflows sharing them with
+// workflow are not changed.
+func (m *credentialsMap) rewrite(workflow *n8n.Workflow, fn func(node n8n.Node, credentialType string, credential map[string]interface{})) {
+	if workflow.Nodes == nil {
+		return
+	}
+
+	nodes := make([]n8n.Node, len(workflow.Nodes))
+	for i, node := range workflow.Nodes {
+		if node.Credentials != nil {
+			credentials := make(map[string]interface{}, len(*node.Credentials))
+			types := make([]string, 0, len(*node.Credentials))
+			for credentialType, value := range *node.Credentials {
+				credentials[credentialType] = value
+				types = append(types, credentialType)
+			}
+			sort.Strings(types)
+
+			for _, credentialType := range types {
+				credential, ok := credentials[credentialType].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				copied := make(map[string]interface{}, len(credential))
+				for key, value := range credential {
+					copied[key] = value
+				}
+				fn(node, credentialType, copied)
+				credentials[credentialType] = copied
+			}
+			node.Credentials = &credentials
+		}
+		nodes[i] = node
+	}
+	workflow.Nodes = nodes
+}
+
+func nodeName(node n8n.Node) string {
+	if node.Name != nil {
+		return *node.Name
+	}
+	return ""
+}
@@ -28,7 +28,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // DeleteCommand represents the command to delete a workflow
@@ -57,13 +56,14 @@ func deleteWorkflow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("this command requires a workflow ID")
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
-
-	client := n8n.NewClient(instanceURL, apiKey)
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
 	workflowID := args[0]
-	if err := client.DeleteWorkflow(workflowID); err != nil {
+	if err := client.DeleteWorkflow(ctx, workflowID); err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error deleting workflow: %v\n", err)
 		if printErr != nil {
 			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
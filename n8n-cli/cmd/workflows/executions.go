@@ -25,7 +25,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -33,7 +32,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // ExecutionHandler handles execution history commands
@@ -47,17 +45,11 @@ var ExecutionsCmd = &cobra.Command{
 	Short: "Get execution history for workflows",
 	Long:  `Retrieve execution history for n8n workflows. If a workflow ID is provided, only executions for that specific workflow are returned.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		apiKey, ok := viper.Get("api_key").(string)
-		if !ok || apiKey == "" {
-			return fmt.Errorf("API key not found in configuration")
-		}
-
-		instanceURL, ok := viper.Get("instance_url").(string)
-		if !ok || instanceURL == "" {
-			return fmt.Errorf("instance URL not found in configuration")
+		client, err := rootcmd.NewAPIClient(rootcmd.CommandContext(cmd))
+		if err != nil {
+			return err
 		}
 
-		client := n8n.NewClient(instanceURL, apiKey)
 		handler := ExecutionHandler{Client: client}
 		return handler.Handle(cmd, args)
 	},
@@ -94,7 +86,8 @@ func (h ExecutionHandler) Handle(cmd *cobra.Command, args []string) error {
 		workflowID = args[0]
 	}
 
-	executions, err := h.Client.GetExecutions(workflowID, includeData, status, limit, cursor)
+	ctx := rootcmd.CommandContext(cmd)
+	executions, err := h.Client.GetExecutions(ctx, workflowID, includeData, status, limit, cursor)
 	if err != nil {
 		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error getting executions: %v\n", err)
 		if printErr != nil {
@@ -181,65 +174,36 @@ func printExecutions(out io.Writer, executions *n8n.ExecutionList, maxNodes int)
 
 		flowPath := "N/A"
 
-		if execution.Data != nil {
-			if resultData, ok := (*execution.Data)["resultData"].(map[string]interface{}); ok {
-				var lastNode string
-				if lastNodeStr, ok := resultData["lastNodeExecuted"].(string); ok {
-					lastNode = lastNodeStr
-				}
+		runs, lastNode := n8n.ExecutionNodeRuns(execution)
+
+		var flowNodes []string
+		for _, run := range runs {
+			if run.RunIndex > 0 {
+				continue
+			}
+			if run.Node == lastNode {
+				flowNodes = append(flowNodes, run.Node+"*")
+			} else {
+				flowNodes = append(flowNodes, run.Node)
+			}
+		}
 
-				if runData, ok := resultData["runData"].(map[string]interface{}); ok {
-					type NodeExecution struct {
-						Name      string
-						StartTime int64
-					}
-
-					var nodeExecutions []NodeExecution
-					for nodeName, nodeData := range runData {
-						if dataArray, ok := nodeData.([]interface{}); ok && len(dataArray) > 0 {
-							if execution, ok := dataArray[0].(map[string]interface{}); ok {
-								if startTime, ok := execution["startTime"].(float64); ok {
-									nodeExecutions = append(nodeExecutions, NodeExecution{
-										Name:      nodeName,
-										StartTime: int64(startTime),
-									})
-								}
-							}
-						}
-					}
-
-					sort.Slice(nodeExecutions, func(i, j int) bool {
-						return nodeExecutions[i].StartTime < nodeExecutions[j].StartTime
-					})
-
-					var flowNodes []string
-					for _, node := range nodeExecutions {
-						if node.Name == lastNode {
-							flowNodes = append(flowNodes, node.Name+"*")
-						} else {
-							flowNodes = append(flowNodes, node.Name)
-						}
-					}
-
-					if len(flowNodes) > 0 {
-						if maxNodes <= 0 || len(flowNodes) <= maxNodes {
-							flowPath = strings.Join(flowNodes, " → ")
-						} else if maxNodes == 1 {
-							if flowNodes[0] == lastNode+"*" {
-								flowPath = flowNodes[0]
-							} else {
-								flowPath = flowNodes[0] + " → ..."
-							}
-						} else {
-							firstNodes := maxNodes - 2
-							if firstNodes < 1 {
-								firstNodes = 1
-							}
-							flowPath = strings.Join(flowNodes[:firstNodes], " → ") +
-								" → ... → " + flowNodes[len(flowNodes)-1]
-						}
-					}
+		if len(flowNodes) > 0 {
+			if maxNodes <= 0 || len(flowNodes) <= maxNodes {
+				flowPath = strings.Join(flowNodes, " → ")
+			} else if maxNodes == 1 {
+				if flowNodes[0] == lastNode+"*" {
+					flowPath = flowNodes[0]
+				} else {
+					flowPath = flowNodes[0] + " → ..."
+				}
+			} else {
+				firstNodes := maxNodes - 2
+				if firstNodes < 1 {
+					firstNodes = 1
 				}
+				flowPath = strings.Join(flowNodes[:firstNodes], " → ") +
+					" → ... → " + flowNodes[len(flowNodes)-1]
 			}
 		}
 
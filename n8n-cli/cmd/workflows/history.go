@@ -0,0 +1,152 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// historyEntry is a version of a workflow listed by the history command
+type historyEntry struct {
+	VersionID string     `json:"versionId" yaml:"versionId"`
+	Status    string     `json:"status" yaml:"status"`
+	CreatedAt *time.Time `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	Authors   string     `json:"authors,omitempty" yaml:"authors,omitempty"`
+}
+
+// HistoryCmd represents the workflow history command
+var HistoryCmd = &cobra.Command{
+	Use:   "history WORKFLOW_ID",
+	Short: "List the known versions of a workflow",
+	Long: `List the known versions of a workflow: the version currently being
+edited and the published version.
+
+The n8n API has no endpoint that lists the whole version history, so older
+version IDs have to be taken from the workflow history in the n8n editor. Any
+version ID can be shown with "history show" and restored with "history restore".`,
+	Example: `  n8n workflows history 2tUt1wbLX592XDdX
+  n8n workflows history show 2tUt1wbLX592XDdX 7c6b9e3f-8d4a-4b2c-9f1e-6a5d3b8c7e4f
+  n8n workflows history restore 2tUt1wbLX592XDdX 7c6b9e3f-8d4a-4b2c-9f1e-6a5d3b8c7e4f`,
+	Args: cobra.ExactArgs(1),
+	RunE: listHistory,
+}
+
+func init() {
+	rootcmd.GetWorkflowsCmd().AddCommand(HistoryCmd)
+
+	HistoryCmd.Flags().StringP("output", "o", formatTable, "Output format: table, json, or yaml")
+}
+
+func listHistory(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	workflowID := args[0]
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	refs, err := client.GetWorkflowVersionRefs(ctx, workflowID)
+	var entries []historyEntry
+	if err == nil {
+		entries = []historyEntry{}
+		if refs.VersionId != "" {
+			entries = append(entries, historyEntry{VersionID: refs.VersionId, Status: "current"})
+		}
+		if refs.ActiveVersion != nil && refs.ActiveVersion.VersionId != "" {
+			if len(entries) > 0 && entries[0].VersionID == refs.ActiveVersion.VersionId {
+				entries[0].Status = "current, published"
+			} else {
+				entries = append(entries, historyEntry{VersionID: refs.ActiveVersion.VersionId, Status: "published"})
+			}
+		}
+
+		for i := range entries {
+			version, versionErr := client.GetWorkflowVersion(ctx, workflowID, entries[i].VersionID)
+			if errors.Is(versionErr, n8n.ErrNotFound) {
+				continue
+			}
+			if versionErr != nil {
+				err = versionErr
+				break
+			}
+			entries[i].CreatedAt = version.CreatedAt
+			entries[i].Authors = version.Authors
+		}
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching workflow history: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	switch output {
+	case formatTable:
+		if len(entries) == 0 {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "No version history available for workflow %s\n", workflowID)
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		if _, err := fmt.Fprintln(w, "VERSION_ID\tCREATED\tAUTHORS\tSTATUS"); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		for _, entry := range entries {
+			created := "N/A"
+			if entry.CreatedAt != nil {
+				created = entry.CreatedAt.Format(time.RFC3339)
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.VersionID, created, entry.Authors, entry.Status); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+		return w.Flush()
+	case formatJSON:
+		jsonData, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling workflow history to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	case formatYAML:
+		yamlData, err := yaml.Marshal(entries)
+		if err != nil {
+			return fmt.Errorf("error marshaling workflow history to YAML: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", output)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflows
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// HistoryRestoreCmd represents the workflow history restore command
+var HistoryRestoreCmd = &cobra.Command{
+	Use:   "restore WORKFLOW_ID VERSION_ID",
+	Short: "Restore a workflow to an earlier version",
+	Long: `Restore the name, nodes and connections of a workflow to those of the given
+version. The changes are shown first and have to be confirmed, unless --yes is
+set. The settings and tags of the workflow are kept.
+
+Restoring saves a new version, so it can be undone by restoring the version
+that was current before.`,
+	Args: cobra.ExactArgs(2),
+	RunE: restoreHistoryVersion,
+}
+
+func init() {
+	HistoryCmd.AddCommand(HistoryRestoreCmd)
+
+	HistoryRestoreCmd.Flags().Bool("dry-run", false, "Show the changes without restoring the version")
+	HistoryRestoreCmd.Flags().BoolP("yes", "y", false, "Restore without asking for confirmation")
+}
+
+func restoreHistoryVersion(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	workflowID, versionID := args[0], args[1]
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, err := client.GetWorkflow(ctx, workflowID)
+	var version *n8n.WorkflowVersion
+	if err == nil {
+		version, err = client.GetWorkflowVersion(ctx, workflowID, versionID)
+	}
+	var refs *n8n.WorkflowVersionRefs
+	if err == nil {
+		refs, err = client.GetWorkflowVersionRefs(ctx, workflowID)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error restoring workflow version: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	restored := n8n.CleanWorkflow(*current)
+	restored.Nodes = version.Nodes
+	restored.Connections = version.Connections
+	if restored.Connections == nil {
+		restored.Connections = make(map[string]interface{})
+	}
+	if version.Name != nil && *version.Name != "" {
+		restored.Name = *version.Name
+	}
+
+	out := cmd.OutOrStdout()
+	changes := workflowDiff(*current, restored)
+	if len(changes) == 0 {
+		_, err := fmt.Fprintf(out, "Workflow '%s' (ID: %s) already matches version %s\n", current.Name, workflowID, versionID)
+		return err
+	}
+
+	lines := append([]string{fmt.Sprintf("Restoring version %s changes workflow '%s' (ID: %s):", versionID, current.Name, workflowID)}, changes...)
+	if _, err := fmt.Fprintln(out, strings.Join(lines, "\n")); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	if dryRun {
+		return nil
+	}
+
+	if !yes {
+		if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Restore version %s? [y/N]: ", versionID); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+		answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && answer == "" {
+			return fmt.Errorf("no confirmation given: use --yes to restore without confirmation")
+		}
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			_, err := fmt.Fprintln(out, "Restore cancelled")
+			return err
+		}
+	}
+
+	if _, err := client.UpdateWorkflow(ctx, workflowID, &restored); err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error restoring workflow version: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	if _, err := fmt.Fprintf(out, "Workflow '%s' (ID: %s) has been restored to version %s\n", restored.Name, workflowID, versionID); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+	if refs.VersionId != "" && refs.VersionId != versionID {
+		if _, err := fmt.Fprintf(out, "To undo, run: n8n workflows history restore %s %s\n", workflowID, refs.VersionId); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	return nil
+}
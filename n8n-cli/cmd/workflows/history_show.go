@@ -0,0 +1,89 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflows
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// HistoryShowCmd represents the workflow history show command
+var HistoryShowCmd = &cobra.Command{
+	Use:   "show WORKFLOW_ID VERSION_ID",
+	Short: "Print a version of a workflow",
+	Long:  `Print the name, nodes and connections of a workflow as they were in the given version.`,
+	Args:  cobra.ExactArgs(2),
+	RunE:  showHistoryVersion,
+}
+
+func init() {
+	HistoryCmd.AddCommand(HistoryShowCmd)
+
+	HistoryShowCmd.Flags().StringP("output", "o", formatJSON, "Output format: json or yaml")
+}
+
+func showHistoryVersion(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	if output != formatJSON && output != formatYAML {
+		return fmt.Errorf("unsupported output format: %s. Supported formats: json, yaml", output)
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	version, err := client.GetWorkflowVersion(ctx, args[0], args[1])
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error fetching workflow version: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	jsonData, err := json.MarshalIndent(version, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling workflow version to JSON: %w", err)
+	}
+	if output == formatJSON {
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(jsonData))
+		return err
+	}
+
+	// Convert through JSON so that the YAML keys match the JSON field names
+	var generic interface{}
+	if err := json.Unmarshal(jsonData, &generic); err != nil {
+		return fmt.Errorf("error converting workflow version to YAML: %w", err)
+	}
+	yamlData, err := yaml.Marshal(generic)
+	if err != nil {
+		return fmt.Errorf("error marshaling workflow version to YAML: %w", err)
+	}
+	_, err = fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+	return err
+}
@@ -32,7 +32,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -130,12 +129,13 @@ func printWorkflowYAML(cmd *cobra.Command, workflows []n8n.Workflow) error {
 
 // listWorkflows fetches and lists workflows from the n8n instance
 func listWorkflows(cmd *cobra.Command, args []string) error {
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
-
-	client := n8n.NewClient(instanceURL, apiKey)
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
-	workflowList, err := client.GetWorkflows()
+	workflowList, err := client.GetWorkflows(ctx)
 	if err != nil {
 		return err
 	}
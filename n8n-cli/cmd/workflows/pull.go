@@ -22,6 +22,7 @@ THE SOFTWARE.
 package workflows
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -29,7 +30,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // pullCmd represents the pull command
@@ -52,6 +52,7 @@ func init() {
 	pullCmd.Flags().StringP("output", "o", "json", "Output format for workflow file (json or yaml)")
 	pullCmd.Flags().Bool("no-truncate", false, "Include all fields in output files, including null and optional fields")
 	pullCmd.Flags().Bool("dry-run", false, "Show what would be updated without making changes")
+	pullCmd.Flags().String("credentials-map", "", "File mapping the credential names used by nodes to the credential IDs of the instance, the IDs are replaced with the names")
 	rootcmd.GetWorkflowsCmd().AddCommand(pullCmd)
 
 	// nolint:errcheck
@@ -88,9 +89,16 @@ func PullWorkflow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("workflow id, name, or file is required")
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
-	client := n8n.NewClient(instanceURL, apiKey)
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
 	localFilePath, localWorkflow, localFound, err := findLocalWorkflowByName(directory, workflowName)
 	if err != nil {
@@ -98,7 +106,7 @@ func PullWorkflow(cmd *cobra.Command, args []string) error {
 	}
 
 	if workflowID == "" && workflowName != "" {
-		resolvedID, resolveErr := resolveWorkflowIDByName(client, workflowName)
+		resolvedID, resolveErr := resolveWorkflowIDByName(ctx, client, workflowName)
 		if resolveErr != nil {
 			if !isWorkflowNameNotFound(resolveErr) {
 				return resolveErr
@@ -129,7 +137,7 @@ func PullWorkflow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("workflow '%s' not found on server and no local ID in %s", workflowName, directory)
 	}
 
-	workflow, err := client.GetWorkflow(workflowID)
+	workflow, err := client.GetWorkflow(ctx, workflowID)
 	if err != nil {
 		return fmt.Errorf("error fetching workflow: %w", err)
 	}
@@ -181,13 +189,9 @@ func PullWorkflow(cmd *cobra.Command, args []string) error {
 	}
 
 	minimal := !noTruncate
-	return refreshWorkflowToFile(cmd, *workflow, filePath, dryRun, minimal)
+	return refreshWorkflowToFile(cmd, credentials, *workflow, filePath, dryRun, minimal)
 }
 
 func isWorkflowNameNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	msg := err.Error()
-	return strings.Contains(msg, "workflow with name") && strings.Contains(msg, "not found")
+	return errors.Is(err, n8n.ErrNotFound)
 }
@@ -26,9 +26,7 @@ import (
 	"path/filepath"
 
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
-	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // pushCmd represents the push command
@@ -49,6 +47,7 @@ func init() {
 	pushCmd.Flags().String("id", "", "Workflow ID to push")
 	pushCmd.Flags().StringP("name", "n", "", "Workflow name to push")
 	pushCmd.Flags().Bool("dry-run", false, "Show what would be uploaded without making changes")
+	pushCmd.Flags().String("credentials-map", "", "File mapping the credential names used by nodes to the credential IDs of the instance")
 	rootcmd.GetWorkflowsCmd().AddCommand(pushCmd)
 
 	// nolint:errcheck
@@ -101,9 +100,16 @@ func PushWorkflow(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
-	client := n8n.NewClient(instanceURL, apiKey)
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
 	workflow, err := readWorkflowFromFile(filePath)
 	if err != nil {
@@ -113,7 +119,7 @@ func PushWorkflow(cmd *cobra.Command, args []string) error {
 	if workflowID != "" {
 		workflow.Id = &workflowID
 	} else if workflowName != "" {
-		resolvedID, resolveErr := resolveWorkflowIDByName(client, workflowName)
+		resolvedID, resolveErr := resolveWorkflowIDByName(ctx, client, workflowName)
 		if resolveErr == nil {
 			workflow.Id = &resolvedID
 		} else if !isWorkflowNameNotFound(resolveErr) {
@@ -122,7 +128,7 @@ func PushWorkflow(cmd *cobra.Command, args []string) error {
 	}
 
 	filename := filepath.Base(filePath)
-	result, err := processWorkflowPayload(client, cmd, &workflow, filename, filePath, dryRun)
+	result, err := processWorkflowPayload(client, cmd, credentials, &workflow, filename, filePath, dryRun)
 	if err != nil {
 		return err
 	}
@@ -32,7 +32,6 @@ import (
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -58,6 +57,7 @@ func init() {
 	refreshCmd.Flags().Bool("all", false, "Refresh all workflows from n8n instance, not just those in the directory")
 	refreshCmd.Flags().String("id", "", "Workflow ID to refresh (used with --file)")
 	refreshCmd.Flags().String("name", "", "Workflow name to refresh (used with --file)")
+	refreshCmd.Flags().String("credentials-map", "", "File mapping the credential names used by nodes to the credential IDs of the instance, the IDs are replaced with the names")
 	rootcmd.GetWorkflowsCmd().AddCommand(refreshCmd)
 
 	// nolint:errcheck
@@ -89,10 +89,15 @@ func RefreshWorkflows(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("use either --id or --name, not both")
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
+	client, err := rootcmd.NewAPIClient(rootcmd.CommandContext(cmd))
+	if err != nil {
+		return err
+	}
 
 	minimal := !noTruncate
 
@@ -101,14 +106,27 @@ func RefreshWorkflows(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		return RefreshSingleWorkflowWithClient(cmd, client, filePath, workflowID, workflowName, dryRun, minimal)
+		return refreshSingleWorkflow(cmd, client, credentials, filePath, workflowID, workflowName, dryRun, minimal)
 	}
 
-	return RefreshWorkflowsWithClient(cmd, client, directory, dryRun, overwrite, output, minimal, all)
+	return refreshWorkflows(cmd, client, credentials, directory, dryRun, overwrite, output, minimal, all)
 }
 
 // RefreshWorkflowsWithClient is the testable version of RefreshWorkflows that accepts a client interface
 func RefreshWorkflowsWithClient(cmd *cobra.Command, client n8n.ClientInterface, directory string, dryRun bool, overwrite bool, output string, minimal bool, all bool) error {
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
+
+	return refreshWorkflows(cmd, client, credentials, directory, dryRun, overwrite, output, minimal, all)
+}
+
+// refreshWorkflows writes the workflows of the instance to directory, with the
+// credentials in the map replaced by their names
+func refreshWorkflows(cmd *cobra.Command, client n8n.ClientInterface, credentials *credentialsMap, directory string, dryRun bool, overwrite bool, output string, minimal bool, all bool) error {
+	ctx := rootcmd.CommandContext(cmd)
+
 	if err := ensureDirectoryExists(cmd, directory, dryRun); err != nil {
 		return err
 	}
@@ -118,10 +136,12 @@ func RefreshWorkflowsWithClient(cmd *cobra.Command, client n8n.ClientInterface,
 		return err
 	}
 
+	var refreshed []string
+
 	if all || len(localFiles) == 0 {
 		cmd.Println("Refreshing all workflows from n8n instance")
 
-		workflowList, err := client.GetWorkflows()
+		workflowList, err := client.GetWorkflows(ctx)
 		if err != nil {
 			return fmt.Errorf("error fetching workflows: %w", err)
 		}
@@ -132,28 +152,43 @@ func RefreshWorkflowsWithClient(cmd *cobra.Command, client n8n.ClientInterface,
 		}
 
 		for _, workflow := range *workflowList.Data {
-			if err := processWorkflow(cmd, workflow, localFiles, directory, dryRun, overwrite, output, minimal); err != nil {
+			if ctx.Err() != nil {
+				printInterruptedSummary(cmd, "refreshed", refreshed)
+				return fmt.Errorf("refresh interrupted: %w", ctx.Err())
+			}
+
+			if err := processWorkflow(cmd, credentials, workflow, localFiles, directory, dryRun, overwrite, output, minimal); err != nil {
 				return err
 			}
+			refreshed = append(refreshed, workflow.Name)
 		}
 	} else {
 		cmd.Println("Refreshing only workflows that exist in the directory")
 
-		refreshed := 0
 		for workflowID := range localFiles {
-			workflow, err := client.GetWorkflow(workflowID)
+			if ctx.Err() != nil {
+				printInterruptedSummary(cmd, "refreshed", refreshed)
+				return fmt.Errorf("refresh interrupted: %w", ctx.Err())
+			}
+
+			workflow, err := client.GetWorkflow(ctx, workflowID)
 			if err != nil {
 				cmd.Printf("Warning: Could not fetch workflow with ID %s: %v\n", workflowID, err)
 				continue
 			}
 
-			if err := processWorkflow(cmd, *workflow, localFiles, directory, dryRun, overwrite, output, minimal); err != nil {
+			if err := processWorkflow(cmd, credentials, *workflow, localFiles, directory, dryRun, overwrite, output, minimal); err != nil {
 				return err
 			}
-			refreshed++
+			refreshed = append(refreshed, workflow.Name)
 		}
 
-		if refreshed == 0 {
+		if ctx.Err() != nil {
+			printInterruptedSummary(cmd, "refreshed", refreshed)
+			return fmt.Errorf("refresh interrupted: %w", ctx.Err())
+		}
+
+		if len(refreshed) == 0 {
 			cmd.Println("No workflows were refreshed. Either the local workflows don't exist in the n8n instance or there was an error fetching them. Try refresh --all and delete the local files you don't want to track.")
 		}
 	}
@@ -164,6 +199,17 @@ func RefreshWorkflowsWithClient(cmd *cobra.Command, client n8n.ClientInterface,
 
 // RefreshSingleWorkflowWithClient refreshes a single workflow file by ID or name.
 func RefreshSingleWorkflowWithClient(cmd *cobra.Command, client n8n.ClientInterface, filePath string, workflowID string, workflowName string, dryRun bool, minimal bool) error {
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
+
+	return refreshSingleWorkflow(cmd, client, credentials, filePath, workflowID, workflowName, dryRun, minimal)
+}
+
+func refreshSingleWorkflow(cmd *cobra.Command, client n8n.ClientInterface, credentials *credentialsMap, filePath string, workflowID string, workflowName string, dryRun bool, minimal bool) error {
+	ctx := rootcmd.CommandContext(cmd)
+
 	parentDir := filepath.Dir(filePath)
 	if parentDir != "." {
 		if err := ensureDirectoryExists(cmd, parentDir, dryRun); err != nil {
@@ -191,23 +237,23 @@ func RefreshSingleWorkflowWithClient(cmd *cobra.Command, client n8n.ClientInterf
 	var err error
 
 	if workflowID != "" {
-		workflow, err = client.GetWorkflow(workflowID)
+		workflow, err = client.GetWorkflow(ctx, workflowID)
 		if err != nil {
 			return fmt.Errorf("error fetching workflow: %w", err)
 		}
 	} else {
-		resolvedID, err := resolveWorkflowIDByName(client, workflowName)
+		resolvedID, err := resolveWorkflowIDByName(ctx, client, workflowName)
 		if err != nil {
 			return err
 		}
 
-		workflow, err = client.GetWorkflow(resolvedID)
+		workflow, err = client.GetWorkflow(ctx, resolvedID)
 		if err != nil {
 			return fmt.Errorf("error fetching workflow: %w", err)
 		}
 	}
 
-	return refreshWorkflowToFile(cmd, *workflow, filePath, dryRun, minimal)
+	return refreshWorkflowToFile(cmd, credentials, *workflow, filePath, dryRun, minimal)
 }
 
 // ensureDirectoryExists checks if the directory exists and creates it if needed
@@ -382,7 +428,7 @@ func workflowNeedsUpdate(filePath string, existingPath string, content []byte, m
 }
 
 // processWorkflow handles processing of a single workflow
-func processWorkflow(cmd *cobra.Command, workflow n8n.Workflow, localFiles map[string]string,
+func processWorkflow(cmd *cobra.Command, credentials *credentialsMap, workflow n8n.Workflow, localFiles map[string]string,
 	directory string, dryRun bool, overwrite bool, output string, minimal bool) error {
 
 	if workflow.Id == nil || *workflow.Id == "" {
@@ -402,6 +448,8 @@ func processWorkflow(cmd *cobra.Command, workflow n8n.Workflow, localFiles map[s
 		}
 	}
 
+	credentials.neutralize(&workflow)
+
 	content, err := serializeWorkflow(workflow, filePath, minimal, originalName)
 	if err != nil {
 		return err
@@ -441,7 +489,7 @@ func processWorkflow(cmd *cobra.Command, workflow n8n.Workflow, localFiles map[s
 	return nil
 }
 
-func refreshWorkflowToFile(cmd *cobra.Command, workflow n8n.Workflow, filePath string, dryRun bool, minimal bool) error {
+func refreshWorkflowToFile(cmd *cobra.Command, credentials *credentialsMap, workflow n8n.Workflow, filePath string, dryRun bool, minimal bool) error {
 	if workflow.Id == nil || *workflow.Id == "" {
 		cmd.Printf("Skipping workflow '%s' with no ID\n", workflow.Name)
 		return nil
@@ -456,6 +504,8 @@ func refreshWorkflowToFile(cmd *cobra.Command, workflow n8n.Workflow, filePath s
 		}
 	}
 
+	credentials.neutralize(&workflow)
+
 	content, err := serializeWorkflow(workflow, filePath, minimal, originalName)
 	if err != nil {
 		return err
@@ -22,17 +22,17 @@ THE SOFTWARE.
 package workflows
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strings"
 
-	"github.com/edenreich/n8n-cli/cmd"
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -107,7 +107,7 @@ This command processes JSON and YAML workflow files and ensures they exist on yo
 }
 
 func init() {
-	cmd.GetWorkflowsCmd().AddCommand(SyncCmd)
+	rootcmd.GetWorkflowsCmd().AddCommand(SyncCmd)
 
 	SyncCmd.Flags().StringP("directory", "d", "", "Directory containing workflow files (JSON/YAML)")
 	SyncCmd.Flags().StringP("file", "f", "", "Single workflow file path (JSON/YAML)")
@@ -118,6 +118,7 @@ func init() {
 	SyncCmd.Flags().Bool("all", false, "Refresh all workflows from n8n instance when refreshing, not just those in the directory")
 	SyncCmd.Flags().String("id", "", "Workflow ID to sync (used with --file)")
 	SyncCmd.Flags().String("name", "", "Workflow name to sync (used with --file)")
+	SyncCmd.Flags().String("credentials-map", "", "File mapping the credential names used by nodes to the credential IDs of the instance")
 
 	// nolint:errcheck
 	SyncCmd.MarkFlagFilename("file", "json", "yaml", "yml")
@@ -151,17 +152,23 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--prune is only supported with --directory")
 	}
 
-	apiKey := viper.Get("api_key").(string)
-	instanceURL := viper.Get("instance_url").(string)
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return err
+	}
 
-	client := n8n.NewClient(instanceURL, apiKey)
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
 
 	if filePath != "" {
 		if err := validateWorkflowFileExtension(filePath); err != nil {
 			return err
 		}
 
-		result, err := syncSingleWorkflowFile(client, cmd, filePath, dryRun, workflowID, workflowName)
+		result, err := syncSingleWorkflowFile(client, cmd, credentials, filePath, dryRun, workflowID, workflowName)
 		if err != nil {
 			return err
 		}
@@ -171,10 +178,10 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 			noTruncate := false
 			minimal := !noTruncate
 
-			workflow, err := client.GetWorkflow(result.WorkflowID)
+			workflow, err := client.GetWorkflow(ctx, result.WorkflowID)
 			if err != nil {
 				cmd.Printf("Error refreshing workflow after sync: %v\n", err)
-			} else if refreshErr := refreshWorkflowToFile(cmd, *workflow, filePath, false, minimal); refreshErr != nil {
+			} else if refreshErr := refreshWorkflowToFile(cmd, credentials, *workflow, filePath, false, minimal); refreshErr != nil {
 				cmd.Printf("Error refreshing workflow after sync: %v\n", refreshErr)
 			} else {
 				cmd.Println("Local workflow file updated successfully with remote state")
@@ -191,12 +198,18 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 
 	localWorkflowIDs := make(map[string]bool)
 	updatedWorkflows := make(map[string]bool)
+	var processed []string
 
 	for _, file := range files {
 		if file.IsDir() {
 			continue
 		}
 
+		if ctx.Err() != nil {
+			printInterruptedSummary(cmd, "synced", processed)
+			return fmt.Errorf("sync interrupted: %w", ctx.Err())
+		}
+
 		ext := strings.ToLower(filepath.Ext(file.Name()))
 		if ext == ".json" || ext == ".yaml" || ext == ".yml" {
 			filePath := filepath.Join(directory, file.Name())
@@ -205,8 +218,12 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 				localWorkflowIDs[workflowID] = true
 			}
 
-			result, err := ProcessWorkflowFile(client, cmd, filePath, dryRun, prune)
+			result, err := processWorkflowFile(client, cmd, credentials, filePath, dryRun)
 			if err != nil {
+				if ctx.Err() != nil {
+					printInterruptedSummary(cmd, "synced", processed)
+					return fmt.Errorf("sync interrupted while processing %s: %w", filePath, ctx.Err())
+				}
 				cmd.Printf("Error processing workflow file %s: %v\n", filePath, err)
 				continue
 			}
@@ -214,6 +231,7 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 			if result.WorkflowID != "" {
 				updatedWorkflows[result.WorkflowID] = true
 			}
+			processed = append(processed, fmt.Sprintf("%s (%s)", result.Name, filepath.Base(filePath)))
 		}
 	}
 
@@ -235,7 +253,7 @@ func SyncWorkflows(cmd *cobra.Command, args []string) error {
 			cmd.Println("No output format specified, maintaining existing file formats")
 		}
 
-		if err := RefreshWorkflowsWithClient(cmd, client, directory, false, overwrite, output, minimal, all); err != nil {
+		if err := refreshWorkflows(cmd, client, credentials, directory, false, overwrite, output, minimal, all); err != nil {
 			cmd.Printf("Error refreshing workflows after sync: %v\n", err)
 		} else {
 			cmd.Println("Local workflow files updated successfully with remote state")
@@ -256,22 +274,37 @@ type WorkflowResult struct {
 
 // ProcessWorkflowFile processes a workflow file and uploads it to n8n
 func ProcessWorkflowFile(client n8n.ClientInterface, cmd *cobra.Command, filePath string, dryRun bool, prune bool) (WorkflowResult, error) {
+	credentials, err := loadCredentialsMap(cmd)
+	if err != nil {
+		return WorkflowResult{FilePath: filePath}, err
+	}
+
+	return processWorkflowFile(client, cmd, credentials, filePath, dryRun)
+}
+
+func processWorkflowFile(client n8n.ClientInterface, cmd *cobra.Command, credentials *credentialsMap, filePath string, dryRun bool) (WorkflowResult, error) {
 	workflow, err := readWorkflowFromFile(filePath)
 	if err != nil {
 		return WorkflowResult{FilePath: filePath}, err
 	}
 
-	return processWorkflowPayload(client, cmd, &workflow, filepath.Base(filePath), filePath, dryRun)
+	return processWorkflowPayload(client, cmd, credentials, &workflow, filepath.Base(filePath), filePath, dryRun)
 }
 
-func processWorkflowPayload(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, filename string, filePath string, dryRun bool) (WorkflowResult, error) {
+func processWorkflowPayload(client n8n.ClientInterface, cmd *cobra.Command, credentials *credentialsMap, workflow *n8n.Workflow, filename string, filePath string, dryRun bool) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	result := WorkflowResult{
 		FilePath: filePath,
 		Name:     workflow.Name,
 	}
 
-	var err error
+	for _, warning := range credentials.resolve(workflow) {
+		cmd.PrintErrf("Warning: %s in %s\n", warning, filename)
+	}
+
 	var remoteWorkflow *n8n.Workflow
+	var err error
 
 	if workflow.Id == nil || *workflow.Id == "" {
 		result, err = CreateWorkflow(client, cmd, workflow, filename, dryRun, result)
@@ -281,8 +314,12 @@ func processWorkflowPayload(client n8n.ClientInterface, cmd *cobra.Command, work
 		return processActivationAndTags(client, cmd, workflow, result, dryRun)
 	}
 
-	remoteWorkflow, err = client.GetWorkflow(*workflow.Id)
+	remoteWorkflow, err = client.GetWorkflow(ctx, *workflow.Id)
 	if err != nil {
+		if !errors.Is(err, n8n.ErrNotFound) {
+			return result, fmt.Errorf("error fetching workflow %s: %w", *workflow.Id, err)
+		}
+
 		result, err = CreateWorkflowWithID(client, cmd, workflow, filename, dryRun, result)
 		if err != nil {
 			return result, err
@@ -309,7 +346,9 @@ func processWorkflowPayload(client n8n.ClientInterface, cmd *cobra.Command, work
 	return processActivationAndTags(client, cmd, workflow, result, dryRun)
 }
 
-func syncSingleWorkflowFile(client n8n.ClientInterface, cmd *cobra.Command, filePath string, dryRun bool, workflowID string, workflowName string) (WorkflowResult, error) {
+func syncSingleWorkflowFile(client n8n.ClientInterface, cmd *cobra.Command, credentials *credentialsMap, filePath string, dryRun bool, workflowID string, workflowName string) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	workflow, err := readWorkflowFromFile(filePath)
 	if err != nil {
 		return WorkflowResult{FilePath: filePath}, err
@@ -320,14 +359,14 @@ func syncSingleWorkflowFile(client n8n.ClientInterface, cmd *cobra.Command, file
 	}
 
 	if workflowID == "" && workflowName != "" {
-		resolvedID, err := resolveWorkflowIDByName(client, workflowName)
+		resolvedID, err := resolveWorkflowIDByName(ctx, client, workflowName)
 		if err != nil {
 			return WorkflowResult{FilePath: filePath}, err
 		}
 		workflow.Id = &resolvedID
 	}
 
-	return processWorkflowPayload(client, cmd, &workflow, filepath.Base(filePath), filePath, dryRun)
+	return processWorkflowPayload(client, cmd, credentials, &workflow, filepath.Base(filePath), filePath, dryRun)
 }
 
 // ExtractWorkflowIDFromFile reads a workflow file and extracts the workflow ID if present
@@ -367,7 +406,9 @@ func ExtractWorkflowIDFromFile(filePath string) (string, error) {
 
 // PruneWorkflows removes workflows from n8n that are not in the local workflow files
 func PruneWorkflows(client n8n.ClientInterface, cmd *cobra.Command, localWorkflowIDs map[string]bool) error {
-	workflowList, err := client.GetWorkflows()
+	ctx := rootcmd.CommandContext(cmd)
+
+	workflowList, err := client.GetWorkflows(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting workflows from n8n: %w", err)
 	}
@@ -393,7 +434,7 @@ func PruneWorkflows(client n8n.ClientInterface, cmd *cobra.Command, localWorkflo
 			dryRunMsg := fmt.Sprintf("Would delete workflow '%s' (ID: %s) that was not in local files", workflowName, workflowID)
 
 			err := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-				if err := client.DeleteWorkflow(workflowID); err != nil {
+				if err := client.DeleteWorkflow(ctx, workflowID); err != nil {
 					return "", fmt.Errorf("error deleting workflow %s (%s): %w", workflowName, workflowID, err)
 				}
 				return fmt.Sprintf("Deleted workflow '%s' (ID: %s) that was not in local files", workflowName, workflowID), nil
@@ -408,6 +449,14 @@ func PruneWorkflows(client n8n.ClientInterface, cmd *cobra.Command, localWorkflo
 	return nil
 }
 
+// printInterruptedSummary reports which workflows were handled before the command was cancelled
+func printInterruptedSummary(cmd *cobra.Command, action string, processed []string) {
+	cmd.Printf("Interrupted: %d workflow(s) %s before cancellation\n", len(processed), action)
+	for _, name := range processed {
+		cmd.Printf("  - %s\n", name)
+	}
+}
+
 // ExecuteOrDryRun is a helper function that either performs an action or shows what would happen
 // based on whether dry run mode is enabled
 func ExecuteOrDryRun(cmd *cobra.Command, dryRun bool, dryRunMsg string, fn func() (string, error)) error {
@@ -460,7 +509,7 @@ func DetectWorkflowChanges(local *n8n.Workflow, remote *n8n.Workflow) WorkflowCh
 	remoteCopy.Active = nil
 	remoteCopy.Tags = nil
 
-	changes.NeedsUpdate = cmd.DetectWorkflowDrift(remoteCopy, localCopy, true)
+	changes.NeedsUpdate = rootcmd.DetectWorkflowDrift(remoteCopy, localCopy, true)
 
 	if local.Active != nil && remote.Active != nil {
 		if *local.Active && !*remote.Active {
@@ -472,50 +521,69 @@ func DetectWorkflowChanges(local *n8n.Workflow, remote *n8n.Workflow) WorkflowCh
 		changes.NeedsActivation = true
 	}
 
-	if local.Tags != nil && len(*local.Tags) > 0 {
-		if remote.Tags == nil || len(*remote.Tags) == 0 {
-			changes.NeedsTagsUpdate = true
-		} else {
-			if !reflect.DeepEqual(local.Tags, remote.Tags) {
-				changes.NeedsTagsUpdate = true
-			}
-		}
+	// A file without a tags key leaves the remote tags alone, while an empty
+	// list detaches all of them
+	if local.Tags != nil && !sameTagNames(*local.Tags, remote.Tags) {
+		changes.NeedsTagsUpdate = true
 	}
 
 	return changes
 }
 
-// HandleTagUpdates updates the tags for a workflow if needed
+// sameTagNames reports whether the local and remote tags have the same names,
+// regardless of their order and IDs
+func sameTagNames(local n8n.WorkflowTags, remote *n8n.WorkflowTags) bool {
+	localNames := make(map[string]bool)
+	for _, tag := range local {
+		localNames[tag.Name] = true
+	}
+
+	remoteNames := make(map[string]bool)
+	if remote != nil {
+		for _, tag := range *remote {
+			remoteNames[tag.Name] = true
+		}
+	}
+
+	if len(localNames) != len(remoteNames) {
+		return false
+	}
+	for name := range localNames {
+		if !remoteNames[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// HandleTagUpdates makes the tags of a workflow match the tag names of the local
+// workflow. Tags are matched by name: missing tags are created, and tags the
+// workflow has in n8n but not locally are detached. A workflow without a tags
+// key is left alone, while an empty list detaches every tag.
 func HandleTagUpdates(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, workflowID string, dryRun bool) error {
-	if workflow.Tags == nil || len(*workflow.Tags) == 0 {
+	ctx := rootcmd.CommandContext(cmd)
+
+	if workflow.Tags == nil {
 		return nil
 	}
 
-	var existingTags map[string]string
-	var err error
+	existingTags, err := getExistingTagsMap(ctx, client)
+	if err != nil {
+		return fmt.Errorf("error fetching existing tags: %w", err)
+	}
 
-	if dryRun {
-		existingTags = make(map[string]string)
-	} else {
-		existingTags, err = getExistingTagsMap(client)
-		if err != nil {
-			return fmt.Errorf("error fetching existing tags: %w", err)
-		}
+	remoteTags, err := client.GetWorkflowTags(ctx, workflowID)
+	if err != nil {
+		return fmt.Errorf("error fetching workflow tags: %w", err)
 	}
 
-	var tagIDs n8n.TagIds
+	tagIDs := n8n.TagIds{}
+	wanted := make(map[string]bool)
 	for _, tag := range *workflow.Tags {
-		if tag.Id != nil && *tag.Id != "" {
-			tagIDs = append(tagIDs, struct {
-				Id string `json:"id"`
-			}{Id: *tag.Id})
-			continue
-		}
-
-		if dryRun {
-			cmd.Printf("Would create tag '%s' for workflow '%s'\n", tag.Name, workflow.Name)
+		if wanted[tag.Name] {
 			continue
 		}
+		wanted[tag.Name] = true
 
 		if tagID, exists := existingTags[tag.Name]; exists {
 			tagIDs = append(tagIDs, struct {
@@ -526,18 +594,19 @@ func HandleTagUpdates(client n8n.ClientInterface, cmd *cobra.Command, workflow *
 
 		dryRunMsg := fmt.Sprintf("Would create tag '%s' for workflow '%s'", tag.Name, workflow.Name)
 		createErr := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-			createdTag, err := client.CreateTag(tag.Name)
+			createdTag, err := client.CreateTag(ctx, tag.Name)
 			if err != nil {
 				return "", fmt.Errorf("error creating tag '%s': %w", tag.Name, err)
 			}
-
-			if createdTag.Id != nil {
-				tagIDs = append(tagIDs, struct {
-					Id string `json:"id"`
-				}{Id: *createdTag.Id})
-				existingTags[tag.Name] = *createdTag.Id
+			if createdTag == nil || createdTag.Id == nil {
+				return "", fmt.Errorf("created tag '%s' has no ID", tag.Name)
 			}
 
+			tagIDs = append(tagIDs, struct {
+				Id string `json:"id"`
+			}{Id: *createdTag.Id})
+			existingTags[tag.Name] = *createdTag.Id
+
 			return fmt.Sprintf("Created tag '%s' (ID: %s)", tag.Name, *createdTag.Id), nil
 		})
 
@@ -546,26 +615,35 @@ func HandleTagUpdates(client n8n.ClientInterface, cmd *cobra.Command, workflow *
 		}
 	}
 
-	if len(tagIDs) == 0 && !dryRun {
-		return nil
+	var detached []string
+	for _, tag := range remoteTags {
+		if !wanted[tag.Name] {
+			detached = append(detached, fmt.Sprintf("'%s'", tag.Name))
+		}
+	}
+	detachInfo := ""
+	if len(detached) > 0 {
+		detachInfo = fmt.Sprintf(", detaching %s", strings.Join(detached, ", "))
 	}
 
-	dryRunMsg := fmt.Sprintf("Would update tags for workflow '%s' (ID: %s)", workflow.Name, workflowID)
+	dryRunMsg := fmt.Sprintf("Would update tags for workflow '%s' (ID: %s)%s", workflow.Name, workflowID, detachInfo)
 	return ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-		_, err := client.UpdateWorkflowTags(workflowID, tagIDs)
+		_, err := client.UpdateWorkflowTags(ctx, workflowID, tagIDs)
 		if err != nil {
 			return "", fmt.Errorf("error updating workflow tags: %w", err)
 		}
-		return fmt.Sprintf("Updated tags for workflow '%s' (ID: %s)", workflow.Name, workflowID), nil
+		return fmt.Sprintf("Updated tags for workflow '%s' (ID: %s)%s", workflow.Name, workflowID, detachInfo), nil
 	})
 }
 
 // CreateWorkflow creates a new workflow without ID
 func CreateWorkflow(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, filename string, dryRun bool, result WorkflowResult) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	dryRunMsg := fmt.Sprintf("Would create workflow '%s' from %s", workflow.Name, filename)
 
 	err := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-		w, err := client.CreateWorkflow(workflow)
+		w, err := client.CreateWorkflow(ctx, workflow)
 		if err != nil {
 			return "", fmt.Errorf("error creating workflow: %w", err)
 		}
@@ -579,10 +657,12 @@ func CreateWorkflow(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8
 
 // CreateWorkflowWithID creates a new workflow with a specified ID
 func CreateWorkflowWithID(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, filename string, dryRun bool, result WorkflowResult) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	dryRunMsg := fmt.Sprintf("Would create workflow '%s' with ID %s from %s (ID specified but not found on server)", workflow.Name, *workflow.Id, filename)
 
 	err := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-		w, err := client.CreateWorkflow(workflow)
+		w, err := client.CreateWorkflow(ctx, workflow)
 		if err != nil {
 			return "", fmt.Errorf("error creating workflow: %w", err)
 		}
@@ -596,10 +676,12 @@ func CreateWorkflowWithID(client n8n.ClientInterface, cmd *cobra.Command, workfl
 
 // UpdateWorkflow updates an existing workflow
 func UpdateWorkflow(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, filename string, dryRun bool, result WorkflowResult) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	dryRunMsg := fmt.Sprintf("Would update workflow '%s' (ID: %s) from %s", workflow.Name, *workflow.Id, filename)
 
 	err := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-		w, err := client.UpdateWorkflow(*workflow.Id, workflow)
+		w, err := client.UpdateWorkflow(ctx, *workflow.Id, workflow)
 		if err != nil {
 			return "", fmt.Errorf("error updating workflow: %w", err)
 		}
@@ -613,6 +695,8 @@ func UpdateWorkflow(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8
 
 // processActivationAndTags handles activation/deactivation and tag updates for a workflow
 func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, workflow *n8n.Workflow, result WorkflowResult, dryRun bool) (WorkflowResult, error) {
+	ctx := rootcmd.CommandContext(cmd)
+
 	if result.WorkflowID == "" {
 		return result, nil
 	}
@@ -631,14 +715,14 @@ func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, wo
 			changes.NeedsTagsUpdate = true
 		}
 	} else {
-		remoteWorkflow, fetchErr := client.GetWorkflow(workflowID)
+		remoteWorkflow, fetchErr := client.GetWorkflow(ctx, workflowID)
 		if fetchErr != nil {
 			cmd.Printf("Warning: Could not retrieve workflow details for activation/tag processing: %v\n", fetchErr)
 
 			if workflow.Active != nil && *workflow.Active {
 				changes.NeedsActivation = true
 			}
-			if workflow.Tags != nil && len(*workflow.Tags) > 0 {
+			if workflow.Tags != nil {
 				changes.NeedsTagsUpdate = true
 			}
 		} else {
@@ -651,7 +735,7 @@ func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, wo
 			dryRunMsg := fmt.Sprintf("Would activate workflow '%s' %s", workflowName, idInfo)
 
 			activateErr := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-				_, err := client.ActivateWorkflow(workflowID)
+				_, err := client.ActivateWorkflow(ctx, workflowID)
 				if err != nil {
 					return "", fmt.Errorf("error activating workflow: %w", err)
 				}
@@ -665,7 +749,7 @@ func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, wo
 			dryRunMsg := fmt.Sprintf("Would deactivate workflow '%s' %s", workflowName, idInfo)
 
 			deactivateErr := ExecuteOrDryRun(cmd, dryRun, dryRunMsg, func() (string, error) {
-				_, err := client.DeactivateWorkflow(workflowID)
+				_, err := client.DeactivateWorkflow(ctx, workflowID)
 				if err != nil {
 					return "", fmt.Errorf("error deactivating workflow: %w", err)
 				}
@@ -678,7 +762,7 @@ func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, wo
 		}
 	}
 
-	if changes.NeedsTagsUpdate && workflow.Tags != nil && len(*workflow.Tags) > 0 {
+	if changes.NeedsTagsUpdate {
 		if tagErr := HandleTagUpdates(client, cmd, workflow, workflowID, dryRun); tagErr != nil {
 			return result, tagErr
 		}
@@ -688,10 +772,10 @@ func processActivationAndTags(client n8n.ClientInterface, cmd *cobra.Command, wo
 }
 
 // getExistingTagsMap fetches existing tags from n8n and returns a map of tag name to tag ID
-func getExistingTagsMap(client n8n.ClientInterface) (map[string]string, error) {
+func getExistingTagsMap(ctx context.Context, client n8n.ClientInterface) (map[string]string, error) {
 	tagMap := make(map[string]string)
 
-	tagList, err := client.GetTags()
+	tagList, err := client.GetTags(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching tags: %w", err)
 	}
@@ -0,0 +1,289 @@
+/*
+Copyright Ac 2025 Eden Reich
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/cobra"
+)
+
+// TransferCmd represents the transfer workflow command
+var TransferCmd = &cobra.Command{
+	Use:   "transfer [WORKFLOW_ID|WORKFLOW_NAME]",
+	Short: "Transfer workflows to another project",
+	Long: `Transfer a workflow, identified by its ID or name, to another project. The
+project is identified by its ID or name.
+
+Use --tag instead of a workflow to transfer every workflow with that tag. The
+credentials the workflows use stay in their project unless --with-credentials
+is given, in which case they are transferred to the same project. Only the
+credentials of the workflows that are transferred are considered.`,
+	Example: `  n8n workflows transfer "Lead Intake" --project "Marketing"
+  n8n workflows transfer --tag marketing --project "Marketing" --with-credentials --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: transferWorkflows,
+}
+
+func init() {
+	rootcmd.GetWorkflowsCmd().AddCommand(TransferCmd)
+
+	TransferCmd.Flags().StringP("project", "p", "", "Destination project (ID or name)")
+	TransferCmd.Flags().String("tag", "", "Transfer every workflow with this tag")
+	TransferCmd.Flags().Bool("with-credentials", false, "Also transfer the credentials used by the workflows")
+	TransferCmd.Flags().Bool("dry-run", false, "Show what would be transferred without transferring it")
+	_ = TransferCmd.MarkFlagRequired("project")
+}
+
+// credentialRef is a credential referenced by the nodes of a workflow
+type credentialRef struct {
+	ID   string
+	Name string
+}
+
+func transferWorkflows(cmd *cobra.Command, args []string) error {
+	projectFlag, _ := cmd.Flags().GetString("project")
+	tag, _ := cmd.Flags().GetString("tag")
+	withCredentials, _ := cmd.Flags().GetBool("with-credentials")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if (len(args) == 0) == (tag == "") {
+		return fmt.Errorf("either a workflow or --tag is required")
+	}
+
+	ctx := rootcmd.CommandContext(cmd)
+	client, err := rootcmd.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := rootcmd.ResolveProject(ctx, client, projectFlag)
+	var selected []n8n.Workflow
+	if err == nil {
+		selected, err = selectWorkflows(ctx, client, args, tag)
+	}
+	if err != nil {
+		_, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error transferring workflows: %v\n", err)
+		if printErr != nil {
+			return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+		}
+		return err
+	}
+
+	// Only the credentials of the workflows that moved follow them
+	var moved []n8n.Workflow
+	skipped, failed := 0, 0
+	for _, workflow := range selected {
+		if workflowProjectID(workflow) == *project.Id {
+			skipped++
+			if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Workflow '%s' (ID: %s) is already in project '%s'\n", workflow.Name, *workflow.Id, project.Name); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+			continue
+		}
+
+		message := fmt.Sprintf("Would transfer workflow '%s' (ID: %s) to project '%s'\n", workflow.Name, *workflow.Id, project.Name)
+		if !dryRun {
+			if err := client.TransferWorkflow(ctx, *workflow.Id, *project.Id); err != nil {
+				failed++
+				if _, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error transferring workflow '%s' (ID: %s): %v\n", workflow.Name, *workflow.Id, err); printErr != nil {
+					return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+				}
+				continue
+			}
+			message = fmt.Sprintf("Transferred workflow '%s' (ID: %s) to project '%s'\n", workflow.Name, *workflow.Id, project.Name)
+		}
+		moved = append(moved, workflow)
+		if _, err := fmt.Fprint(cmd.OutOrStdout(), message); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	credentials := workflowCredentials(moved)
+	credentialsTransferred := 0
+	if withCredentials {
+		for _, credential := range credentials {
+			message := fmt.Sprintf("Would transfer credential '%s' (ID: %s) to project '%s'\n", credential.Name, credential.ID, project.Name)
+			if !dryRun {
+				if err := client.TransferCredential(ctx, credential.ID, *project.Id); err != nil {
+					failed++
+					if _, printErr := fmt.Fprintf(cmd.ErrOrStderr(), "Error transferring credential '%s' (ID: %s): %v\n", credential.Name, credential.ID, err); printErr != nil {
+						return fmt.Errorf("failed to write error: %v (original error: %v)", printErr, err)
+					}
+					continue
+				}
+				message = fmt.Sprintf("Transferred credential '%s' (ID: %s) to project '%s'\n", credential.Name, credential.ID, project.Name)
+			}
+			credentialsTransferred++
+			if _, err := fmt.Fprint(cmd.OutOrStdout(), message); err != nil {
+				return fmt.Errorf("failed to write output: %v", err)
+			}
+		}
+	} else if len(credentials) > 0 {
+		names := make([]string, 0, len(credentials))
+		for _, credential := range credentials {
+			names = append(names, fmt.Sprintf("'%s' (ID: %s)", credential.Name, credential.ID))
+		}
+		if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Note: the transferred workflows use %d credential(s) that stay in their project, use --with-credentials to transfer them as well: %s\n", len(credentials), strings.Join(names, ", ")); err != nil {
+			return fmt.Errorf("failed to write output: %v", err)
+		}
+	}
+
+	summary := fmt.Sprintf("Workflows: %d transferred, %d already in the project", len(moved), skipped)
+	if dryRun {
+		summary = fmt.Sprintf("Workflows: %d would be transferred, %d already in the project", len(moved), skipped)
+	}
+	if withCredentials {
+		if dryRun {
+			summary += fmt.Sprintf("; credentials: %d would be transferred", credentialsTransferred)
+		} else {
+			summary += fmt.Sprintf("; credentials: %d transferred", credentialsTransferred)
+		}
+	}
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), summary); err != nil {
+		return fmt.Errorf("failed to write output: %v", err)
+	}
+
+	if failed > 0 {
+		// The results are already printed, the usage would only obscure them
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to transfer %d item(s)", failed)
+	}
+
+	return nil
+}
+
+// selectWorkflows returns the workflow named by args, matched by ID and then by
+// name, or every workflow with the given tag, sorted by name
+func selectWorkflows(ctx context.Context, client n8n.ClientInterface, args []string, tag string) ([]n8n.Workflow, error) {
+	workflowList, err := client.GetWorkflows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching workflows: %w", err)
+	}
+
+	var all []n8n.Workflow
+	if workflowList != nil && workflowList.Data != nil {
+		for _, workflow := range *workflowList.Data {
+			if workflow.Id != nil {
+				all = append(all, workflow)
+			}
+		}
+	}
+
+	if len(args) == 1 {
+		for _, workflow := range all {
+			if *workflow.Id == args[0] {
+				return []n8n.Workflow{workflow}, nil
+			}
+		}
+		id, err := rootcmd.FindWorkflow(args[0], all)
+		if err != nil {
+			return nil, err
+		}
+		for _, workflow := range all {
+			if *workflow.Id == id {
+				return []n8n.Workflow{workflow}, nil
+			}
+		}
+	}
+
+	var selected []n8n.Workflow
+	for _, workflow := range all {
+		if workflow.Tags == nil {
+			continue
+		}
+		for _, workflowTag := range *workflow.Tags {
+			if workflowTag.Name == tag {
+				selected = append(selected, workflow)
+				break
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("workflows with tag '%s' %w", tag, n8n.ErrNotFound)
+	}
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+	return selected, nil
+}
+
+// workflowProjectID returns the ID of the project that owns a workflow, or an
+// empty string when the API did not report it
+func workflowProjectID(workflow n8n.Workflow) string {
+	if workflow.Shared == nil {
+		return ""
+	}
+	for _, shared := range *workflow.Shared {
+		if shared.Role != nil && *shared.Role != "workflow:owner" {
+			continue
+		}
+		if shared.ProjectId != nil {
+			return *shared.ProjectId
+		}
+		if shared.Project != nil && shared.Project.Id != nil {
+			return *shared.Project.Id
+		}
+	}
+	return ""
+}
+
+// workflowCredentials returns the credentials referenced by the nodes of the
+// workflows, each credential once, sorted by name
+func workflowCredentials(workflows []n8n.Workflow) []credentialRef {
+	seen := make(map[string]bool)
+	var credentials []credentialRef
+	for _, workflow := range workflows {
+		for _, node := range workflow.Nodes {
+			if node.Credentials == nil {
+				continue
+			}
+			for _, value := range *node.Credentials {
+				reference, ok := value.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				id, _ := reference["id"].(string)
+				if id == "" || seen[id] {
+					continue
+				}
+				seen[id] = true
+				name, _ := reference["name"].(string)
+				credentials = append(credentials, credentialRef{ID: id, Name: name})
+			}
+		}
+	}
+
+	sort.SliceStable(credentials, func(i, j int) bool {
+		if credentials[i].Name != credentials[j].Name {
+			return credentials[i].Name < credentials[j].Name
+		}
+		return credentials[i].ID < credentials[j].ID
+	})
+	return credentials
+}
@@ -0,0 +1,146 @@
+package workflows
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edenreich/n8n-cli/n8n"
+)
+
+// workflowDiff returns the changes that turn the name, nodes and connections of
+// the workflow from into those of the workflow to, one line per change. Nodes
+// are matched by name; changed nodes and connections are followed by the lines
+// of their JSON that differ.
+func workflowDiff(from, to n8n.Workflow) []string {
+	var lines []string
+	if from.Name != to.Name {
+		lines = append(lines, fmt.Sprintf("~ name: '%s' -> '%s'", from.Name, to.Name))
+	}
+
+	fromNodes, toNodes := nodesByName(from.Nodes), nodesByName(to.Nodes)
+	for _, name := range unionKeys(fromNodes, toNodes) {
+		fromNode, inFrom := fromNodes[name]
+		toNode, inTo := toNodes[name]
+		switch {
+		case !inFrom:
+			lines = append(lines, fmt.Sprintf("+ node '%s' (%s)", name, nodeType(toNode)))
+		case !inTo:
+			lines = append(lines, fmt.Sprintf("- node '%s' (%s)", name, nodeType(fromNode)))
+		default:
+			if changes := jsonLineDiff(fromNode, toNode); len(changes) > 0 {
+				lines = append(lines, fmt.Sprintf("~ node '%s' (%s)", name, nodeType(toNode)))
+				lines = append(lines, changes...)
+			}
+		}
+	}
+
+	for _, source := range unionKeys(from.Connections, to.Connections) {
+		fromConnection, inFrom := from.Connections[source]
+		toConnection, inTo := to.Connections[source]
+		switch {
+		case !inFrom:
+			lines = append(lines, fmt.Sprintf("+ connections from '%s'", source))
+		case !inTo:
+			lines = append(lines, fmt.Sprintf("- connections from '%s'", source))
+		default:
+			if changes := jsonLineDiff(fromConnection, toConnection); len(changes) > 0 {
+				lines = append(lines, fmt.Sprintf("~ connections from '%s'", source))
+				lines = append(lines, changes...)
+			}
+		}
+	}
+
+	return lines
+}
+
+// nodesByName indexes nodes by their name, or by their ID when they have no name
+func nodesByName(nodes []n8n.Node) map[string]n8n.Node {
+	byName := make(map[string]n8n.Node, len(nodes))
+	for i, node := range nodes {
+		switch {
+		case node.Name != nil:
+			byName[*node.Name] = node
+		case node.Id != nil:
+			byName[*node.Id] = node
+		default:
+			byName[fmt.Sprintf("#%d", i)] = node
+		}
+	}
+	return byName
+}
+
+// nodeType returns the type of a node, or N/A when it is not set
+func nodeType(node n8n.Node) string {
+	if node.Type == nil {
+		return "N/A"
+	}
+	return *node.Type
+}
+
+// unionKeys returns the keys of both maps, sorted
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	for key := range a {
+		seen[key] = true
+	}
+	for key := range b {
+		seen[key] = true
+	}
+
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonLineDiff returns the lines of the indented JSON of from and to that differ,
+// prefixed with "-" for removed and "+" for added lines, in the order of a
+// longest common subsequence alignment
+func jsonLineDiff(from, to interface{}) []string {
+	fromJSON, errFrom := json.MarshalIndent(from, "", "  ")
+	toJSON, errTo := json.MarshalIndent(to, "", "  ")
+	if errFrom != nil || errTo != nil {
+		return []string{"    (cannot compare: invalid JSON)"}
+	}
+	if string(fromJSON) == string(toJSON) {
+		return nil
+	}
+
+	a, b := strings.Split(string(fromJSON), "\n"), strings.Split(string(toJSON), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "    - "+strings.TrimSpace(a[i]))
+			i++
+		default:
+			lines = append(lines, "    + "+strings.TrimSpace(b[j]))
+			j++
+		}
+	}
+	return lines
+}
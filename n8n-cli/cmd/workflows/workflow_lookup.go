@@ -2,14 +2,15 @@
 package workflows
 
 import (
+	"context"
 	"fmt"
 
 	rootcmd "github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/n8n"
 )
 
-func resolveWorkflowIDByName(client n8n.ClientInterface, name string) (string, error) {
-	workflowList, err := client.GetWorkflows()
+func resolveWorkflowIDByName(ctx context.Context, client n8n.ClientInterface, name string) (string, error) {
+	workflowList, err := client.GetWorkflows(ctx)
 	if err != nil {
 		return "", fmt.Errorf("error fetching workflows: %w", err)
 	}
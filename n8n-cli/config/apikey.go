@@ -0,0 +1,86 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ResolveAPIKey returns the API key of the current configuration. The first
+// non-empty source wins:
+//
+//  1. the --api-key flag
+//  2. the N8N_API_KEY environment variable
+//  3. N8N_API_KEY in the .env file
+//  4. api_key in the config file
+//  5. the first line printed by api_key_command
+//
+// A key obtained from api_key_command is stored in v, so the command runs at
+// most once per invocation. ENC(...) values are decrypted first, see DecryptSettings.
+func ResolveAPIKey(ctx context.Context, v *viper.Viper) (string, error) {
+	if err := DecryptSettings(v); err != nil {
+		return "", err
+	}
+
+	if apiKey := v.GetString("api_key"); apiKey != "" {
+		return apiKey, nil
+	}
+
+	command := v.GetString("api_key_command")
+	if command == "" {
+		return "", nil
+	}
+
+	apiKey, err := runAPIKeyCommand(ctx, command)
+	if err != nil {
+		return "", err
+	}
+
+	v.Set("api_key", apiKey)
+	return apiKey, nil
+}
+
+// runAPIKeyCommand runs command through the shell and returns the first line of its output
+func runAPIKeyCommand(ctx context.Context, command string) (string, error) {
+	output, err := RunShellCommand(ctx, command)
+	if err != nil {
+		return "", fmt.Errorf("api_key_command failed: %w", err)
+	}
+
+	apiKey, _, _ := strings.Cut(strings.TrimLeft(output, "\r\n"), "\n")
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		return "", fmt.Errorf("api_key_command printed no API key")
+	}
+
+	return apiKey, nil
+}
+
+// RunShellCommand runs command through the shell of the platform and returns what it
+// printed to stdout. The error includes what the command printed to stderr.
+func RunShellCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return "", fmt.Errorf("%w: %s", err, detail)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
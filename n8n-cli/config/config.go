@@ -10,14 +10,21 @@ import (
 
 // Initialize reads in config file and ENV variables if set
 func Initialize() {
-	LoadEnvFile()
-
 	v := viper.GetViper()
 	v.SetEnvPrefix("N8N")
 	v.AutomaticEnv()
 
 	BindEnvSafely(v, "api_key", "N8N_API_KEY")
 	BindEnvSafely(v, "instance_url", "N8N_INSTANCE_URL")
+	BindEnvSafely(v, "api_key_command", "N8N_API_KEY_COMMAND")
+	BindEnvSafely(v, ContextKey, "N8N_CONTEXT")
+	BindEnvSafely(v, "enc_key_file", "N8N_ENC_KEY_FILE")
+	BindEnvSafely(v, "timeout", "N8N_TIMEOUT")
+	BindEnvSafely(v, "ca_file", "N8N_CA_FILE")
+	BindEnvSafely(v, "client_cert", "N8N_CLIENT_CERT")
+	BindEnvSafely(v, "client_key", "N8N_CLIENT_KEY")
+	BindEnvSafely(v, "insecure_skip_verify", "N8N_INSECURE_SKIP_VERIFY")
+	BindEnvSafely(v, "proxy_url", "N8N_PROXY_URL")
 
 	v.SetDefault("instance_url", "http://localhost:5678")
 	v.SetDefault("api_key", "")
@@ -32,6 +39,19 @@ func Initialize() {
 			fmt.Fprintf(os.Stderr, "Warning: Config file error: %v\n", err)
 		}
 	}
+
+	// The .env file overrides the current-context of the config file, but a
+	// context selected with --context or N8N_CONTEXT overrides the .env file
+	explicit := ExplicitContext(v)
+	if !explicit {
+		applyContext(v)
+	}
+
+	LoadEnvFile()
+
+	if explicit {
+		applyContext(v)
+	}
 }
 
 // BindEnvSafely binds an environment variable and logs errors without crashing
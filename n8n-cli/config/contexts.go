@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Configuration keys of the contexts section
+const (
+	// ContextKey selects the context for a single invocation (--context, N8N_CONTEXT)
+	ContextKey = "context"
+	// CurrentContextKey holds the default context in the config file
+	CurrentContextKey = "current-context"
+	// ContextsKey holds the named contexts in the config file
+	ContextsKey = "contexts"
+)
+
+// Contexts returns the settings of every context defined in the config file by name.
+// Context names are case-insensitive.
+func Contexts(v *viper.Viper) map[string]map[string]interface{} {
+	contexts := make(map[string]map[string]interface{})
+
+	raw, ok := v.Get(ContextsKey).(map[string]interface{})
+	if !ok {
+		return contexts
+	}
+
+	for name, settings := range raw {
+		if values, ok := settings.(map[string]interface{}); ok {
+			contexts[name] = values
+		} else {
+			contexts[name] = map[string]interface{}{}
+		}
+	}
+
+	return contexts
+}
+
+// ContextNames returns the sorted names of the contexts defined in the config file
+func ContextNames(v *viper.Viper) []string {
+	contexts := Contexts(v)
+
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ActiveContext returns the name of the context in use: the one selected with
+// --context or N8N_CONTEXT, otherwise the current-context of the config file.
+// It returns an empty string when no context is selected.
+func ActiveContext(v *viper.Viper) string {
+	if name := v.GetString(ContextKey); name != "" {
+		return name
+	}
+
+	return v.GetString(CurrentContextKey)
+}
+
+// ValidateContext returns an error when the active context is not defined in the config file
+func ValidateContext(v *viper.Viper) error {
+	name := ActiveContext(v)
+	if name == "" {
+		return nil
+	}
+
+	if _, ok := Contexts(v)[name]; !ok {
+		return fmt.Errorf("context %q not found in config file (available: %v)", name, ContextNames(v))
+	}
+
+	return nil
+}
+
+// ExplicitContext reports whether the context was selected for this invocation
+// with --context or N8N_CONTEXT rather than taken from current-context
+func ExplicitContext(v *viper.Viper) bool {
+	return v.GetString(ContextKey) != ""
+}
+
+// apiKeySources are the settings a context can provide the API key with
+var apiKeySources = []string{"api_key", "api_key_command"}
+
+// ContextSettings returns the settings of the named context as they are applied.
+// A context that provides the API key, with api_key or api_key_command, replaces
+// both of them, so a top-level api_key does not win over the api_key_command of
+// the context.
+func ContextSettings(v *viper.Viper, name string) (map[string]interface{}, bool) {
+	settings, ok := Contexts(v)[name]
+	if !ok {
+		return nil, false
+	}
+
+	providesKey := false
+	for _, key := range apiKeySources {
+		if _, ok := lookupSetting(settings, []string{key}); ok {
+			providesKey = true
+		}
+	}
+	if !providesKey {
+		return settings, true
+	}
+
+	applied := make(map[string]interface{}, len(settings)+len(apiKeySources))
+	for _, key := range apiKeySources {
+		applied[key] = ""
+	}
+	for key, value := range settings {
+		applied[strings.ToLower(key)] = value
+	}
+
+	return applied, true
+}
+
+// applyContext merges the settings of the active context into the configuration
+// layer of v, where they override the settings merged before them. An unknown
+// context is reported as a warning, ValidateContext fails the command later.
+func applyContext(v *viper.Viper) {
+	name := ActiveContext(v)
+	if name == "" {
+		return
+	}
+
+	settings, ok := ContextSettings(v, name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Warning: context %q not found in config file\n", name)
+		return
+	}
+
+	if err := v.MergeConfigMap(settings); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
@@ -32,14 +32,54 @@ func LoadEnvFile() {
 	LoadEnvFileWithReader(DefaultFileReader, viper.GetViper())
 }
 
-// LoadEnvFileWithReader loads environment variables from a .env file using the provided reader
+// LoadEnvFileWithReader loads environment variables from a .env file using the provided reader.
+// The values are merged into the configuration layer of v, so they override the
+// config file but not flags or variables set in the environment. Values wrapped
+// in ENC(...) are kept encrypted until the API key is resolved, see DecryptSettings.
 func LoadEnvFileWithReader(reader FileReader, v *viper.Viper) {
+	entries := readEnvFile(reader)
+	if len(entries) == 0 {
+		return
+	}
+
+	values := make(map[string]interface{})
+	for _, entry := range entries {
+		if os.Getenv(entry.name) != "" {
+			continue
+		}
+		values[envFileKey(entry.name)] = entry.value
+	}
+
+	if err := v.MergeConfigMap(values); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Error loading .env file: %v\n", err)
+	}
+}
+
+// EnvFileValues returns the N8N_ variables of the .env file by configuration key,
+// such as instance_url for N8N_INSTANCE_URL. Encrypted values are returned as is.
+func EnvFileValues(reader FileReader) map[string]string {
+	values := make(map[string]string)
+	for _, entry := range readEnvFile(reader) {
+		values[envFileKey(entry.name)] = entry.value
+	}
+
+	return values
+}
+
+// envEntry is a variable of the .env file
+type envEntry struct {
+	name  string
+	value string
+}
+
+// readEnvFile returns the N8N_ variables of the .env file in the order they are defined
+func readEnvFile(reader FileReader) []envEntry {
 	envFile, err := reader.Open(".env")
 	if err != nil {
 		cwd, _ := os.Getwd()
 		envFile, err = os.Open(filepath.Join(cwd, ".env"))
 		if err != nil {
-			return
+			return nil
 		}
 	}
 
@@ -49,6 +89,7 @@ func LoadEnvFileWithReader(reader FileReader, v *viper.Viper) {
 		}
 	}()
 
+	var entries []envEntry
 	scanner := bufio.NewScanner(envFile)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -66,15 +107,21 @@ func LoadEnvFileWithReader(reader FileReader, v *viper.Viper) {
 
 		value = strings.Trim(value, `"'`)
 
-		if strings.HasPrefix(key, "N8N_") {
-			viperKey := strings.ToLower(strings.TrimPrefix(key, "N8N_"))
-			if os.Getenv(key) == "" {
-				v.Set(viperKey, value)
-			}
+		if !strings.HasPrefix(key, "N8N_") {
+			continue
 		}
+
+		entries = append(entries, envEntry{name: key, value: value})
 	}
 
 	if err := scanner.Err(); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: Error reading .env file: %v\n", err)
 	}
+
+	return entries
+}
+
+// envFileKey returns the configuration key of a .env variable
+func envFileKey(name string) string {
+	return strings.ToLower(strings.TrimPrefix(name, "N8N_"))
 }
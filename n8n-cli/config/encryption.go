@@ -0,0 +1,213 @@
+package config
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// The encryption scheme matches `openssl enc -aes-256-cbc -pbkdf2 -iter 100000 -salt -base64 -A`,
+// as used by the password_helper-aes.sh script: a "Salted__" header followed by
+// an 8 byte salt and the ciphertext, with key and IV derived by PBKDF2-HMAC-SHA256.
+const (
+	encryptedPrefix = "ENC("
+	encryptedSuffix = ")"
+	saltHeader      = "Salted__"
+	saltSize        = 8
+	pbkdf2Iter      = 100000
+	aesKeySize      = 32
+)
+
+// PassphraseEnvVar is the environment variable holding the passphrase of ENC(...) values
+const PassphraseEnvVar = "ENC_KEY"
+
+// PassphrasePrompt asks the user for the passphrase of ENC(...) values.
+// It is a variable so it can be replaced in tests.
+var PassphrasePrompt = promptPassphrase
+
+// IsEncrypted reports whether value is wrapped in ENC(...)
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix) && strings.HasSuffix(value, encryptedSuffix)
+}
+
+// EncryptValue encrypts plaintext with passphrase and wraps it in ENC(...)
+func EncryptValue(plaintext, passphrase string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("error generating salt: %w", err)
+	}
+
+	block, iv, err := deriveCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	padded := append([]byte(plaintext), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, padded)
+
+	payload := append(append([]byte(saltHeader), salt...), ciphertext...)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(payload) + encryptedSuffix, nil
+}
+
+// DecryptValue decrypts an ENC(...) value produced by EncryptValue or password_helper-aes.sh
+func DecryptValue(value, passphrase string) (string, error) {
+	if !IsEncrypted(value) {
+		return "", fmt.Errorf("value is not in ENC(...) format")
+	}
+
+	encoded := strings.TrimSuffix(strings.TrimPrefix(value, encryptedPrefix), encryptedSuffix)
+	payload, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 in encrypted value: %w", err)
+	}
+
+	if len(payload) < len(saltHeader)+saltSize || string(payload[:len(saltHeader)]) != saltHeader {
+		return "", fmt.Errorf("encrypted value has no salt header")
+	}
+
+	salt := payload[len(saltHeader) : len(saltHeader)+saltSize]
+	ciphertext := payload[len(saltHeader)+saltSize:]
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return "", fmt.Errorf("encrypted value has an invalid length")
+	}
+
+	block, iv, err := deriveCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(plaintext[len(plaintext)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return "", fmt.Errorf("decryption failed, wrong passphrase?")
+	}
+
+	return string(plaintext[:len(plaintext)-padding]), nil
+}
+
+// deriveCipher derives the AES-256 block cipher and IV from passphrase and salt
+func deriveCipher(passphrase string, salt []byte) (cipher.Block, []byte, error) {
+	derived, err := pbkdf2.Key(sha256.New, passphrase, salt, pbkdf2Iter, aesKeySize+aes.BlockSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error deriving key: %w", err)
+	}
+
+	block, err := aes.NewCipher(derived[:aesKeySize])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return block, derived[aesKeySize:], nil
+}
+
+// DecryptSettings replaces the ENC(...) values in effect in v with their plaintext.
+// It runs when the API key is resolved rather than when the configuration is
+// loaded, so commands that never talk to the API do not ask for the passphrase.
+// The passphrase is resolved at most once, and the settings of inactive contexts
+// are left alone.
+func DecryptSettings(v *viper.Viper) error {
+	keys := v.AllKeys()
+	sort.Strings(keys)
+
+	var passphrase string
+	for _, key := range keys {
+		if strings.HasPrefix(key, ContextsKey+".") {
+			continue
+		}
+		value, ok := v.Get(key).(string)
+		if !ok || !IsEncrypted(value) {
+			continue
+		}
+
+		if passphrase == "" {
+			resolved, err := ResolvePassphrase(v, false)
+			if err != nil {
+				return fmt.Errorf("cannot decrypt %s: %w", key, err)
+			}
+			passphrase = resolved
+		}
+
+		decrypted, err := DecryptValue(value, passphrase)
+		if err != nil {
+			return fmt.Errorf("could not decrypt %s: %w", key, err)
+		}
+		v.Set(key, decrypted)
+	}
+
+	return nil
+}
+
+// ResolvePassphrase returns the passphrase of ENC(...) values. It is read from
+// the ENC_KEY environment variable, then from the file named by enc_key_file
+// (env: N8N_ENC_KEY_FILE), and finally prompted for when stdin is a terminal.
+// With confirm set, a prompted passphrase has to be entered twice.
+func ResolvePassphrase(v *viper.Viper, confirm bool) (string, error) {
+	if passphrase := os.Getenv(PassphraseEnvVar); passphrase != "" {
+		return passphrase, nil
+	}
+
+	if keyFile := v.GetString("enc_key_file"); keyFile != "" {
+		content, err := os.ReadFile(keyFile)
+		if err != nil {
+			return "", fmt.Errorf("error reading key file: %w", err)
+		}
+
+		passphrase := strings.TrimRight(string(content), "\r\n")
+		if passphrase == "" {
+			return "", fmt.Errorf("key file %s is empty", keyFile)
+		}
+		return passphrase, nil
+	}
+
+	passphrase, err := PassphrasePrompt("Passphrase for ENC(...) values: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("no passphrase given")
+	}
+
+	if confirm {
+		again, err := PassphrasePrompt("Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	return passphrase, nil
+}
+
+// promptPassphrase reads a passphrase from the terminal without echoing it
+func promptPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("no passphrase available: set %s or enc_key_file", PassphraseEnvVar)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("error reading passphrase: %w", err)
+	}
+
+	return string(passphrase), nil
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Configuration sources, from the highest precedence to the lowest. A context
+// selected with --context or N8N_CONTEXT takes precedence over the .env file.
+const (
+	SourceFlag       = "flag"
+	SourceEnv        = "env"
+	SourceEnvFile    = ".env"
+	SourceContext    = "context"
+	SourceConfigFile = "config file"
+	SourceDefault    = "default"
+)
+
+// Status of a layer in the output of Explain
+const (
+	LayerActive     = "active"
+	LayerOverridden = "overridden"
+	LayerNotLoaded  = "not loaded"
+)
+
+// Layer is the value of a configuration key in one configuration source
+type Layer struct {
+	Source string      `json:"source" yaml:"source"`
+	Origin string      `json:"origin" yaml:"origin"`
+	Value  interface{} `json:"value" yaml:"value"`
+	Status string      `json:"status" yaml:"status"`
+}
+
+// Explain returns every layer that defines the key name, ordered by precedence.
+// The first layer that takes effect is marked active. A config file that exists
+// but is not loaded, because another one was found first, is marked not loaded.
+// Secret values are redacted.
+func Explain(v *viper.Viper, flags *pflag.FlagSet, name string) ([]Layer, error) {
+	key, ok := LookupKey(name)
+	if !ok {
+		return nil, unknownKeyError(strings.ToLower(name))
+	}
+	path := strings.Split(key.Name, ".")
+
+	var layers []Layer
+	add := func(source, origin string, value interface{}, loaded bool) {
+		status := LayerNotLoaded
+		if loaded {
+			status = LayerOverridden
+		}
+		layers = append(layers, Layer{Source: source, Origin: origin, Value: Redact(key.Name, value), Status: status})
+	}
+
+	var flag *pflag.Flag
+	if key.Flag != "" && flags != nil {
+		flag = flags.Lookup(key.Flag)
+	}
+	if flag != nil && flag.Changed {
+		add(SourceFlag, "--"+flag.Name, flag.Value.String(), true)
+	}
+
+	if key.Env != "" {
+		if value := os.Getenv(key.Env); value != "" {
+			add(SourceEnv, key.Env, value, true)
+		}
+	}
+
+	addContext := func() {
+		active := ActiveContext(v)
+		if active == "" || key.Global {
+			return
+		}
+		settings, _ := ContextSettings(v, active)
+		if value, ok := lookupSetting(settings, path); ok {
+			add(SourceContext, active, value, true)
+		}
+	}
+
+	// A context selected with --context or N8N_CONTEXT overrides the .env file
+	explicit := ExplicitContext(v)
+	if explicit {
+		addContext()
+	}
+
+	if value, ok := EnvFileValues(DefaultFileReader)[key.Name]; ok && value != "" {
+		add(SourceEnvFile, "N8N_"+strings.ToUpper(key.Name), value, true)
+	}
+
+	if !explicit {
+		addContext()
+	}
+
+	loadedFile := v.ConfigFileUsed()
+	for _, file := range ConfigFiles(v) {
+		settings, err := ReadSettings(file)
+		if err != nil {
+			continue
+		}
+		if value, ok := lookupSetting(settings, path); ok {
+			add(SourceConfigFile, file, value, sameFile(file, loadedFile))
+		}
+	}
+
+	if flag != nil && flag.DefValue != "" {
+		add(SourceDefault, "--"+flag.Name, flag.DefValue, true)
+	}
+
+	for i := range layers {
+		if layers[i].Status == LayerOverridden {
+			layers[i].Status = LayerActive
+			break
+		}
+	}
+
+	return layers, nil
+}
+
+// ConfigFiles returns the config file in use followed by the other candidate
+// config files, whether they exist or not
+func ConfigFiles(v *viper.Viper) []string {
+	loaded := v.ConfigFileUsed()
+
+	var files []string
+	if loaded != "" {
+		files = append(files, loaded)
+	}
+	for _, candidate := range ConfigFileCandidates() {
+		if !sameFile(candidate, loaded) {
+			files = append(files, candidate)
+		}
+	}
+
+	return files
+}
+
+// sameFile reports whether the paths a and b refer to the same file
+func sameFile(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return absA == absB
+}
+
+// lookupSetting returns the value at path in settings, matching keys case-insensitively like viper
+func lookupSetting(settings map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = settings
+	for _, key := range path {
+		current, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		found := false
+		for name, child := range current {
+			if strings.EqualFold(name, key) {
+				value, found = child, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	return value, value != nil
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFilePath returns the config file in use, or $HOME/.n8n/config.yaml when
+// no config file was found
+func ConfigFilePath(v *viper.Viper) (string, error) {
+	if path := v.ConfigFileUsed(); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error finding home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".n8n", "config.yaml"), nil
+}
+
+// ConfigFileCandidates returns the config files in the order viper searches them:
+// $HOME/.n8n/config.yaml, then ./config.yaml. Only the first one found is loaded.
+func ConfigFileCandidates() []string {
+	var candidates []string
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, ".n8n", "config.yaml"))
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		candidates = append(candidates, filepath.Join(cwd, "config.yaml"))
+	}
+
+	return candidates
+}
+
+// ReadSettings reads the settings of the config file at path
+func ReadSettings(path string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{})
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+
+	return settings, nil
+}
+
+// File is a YAML config file edited in place, keeping comments and key order
+type File struct {
+	path string
+	root *yaml.Node
+}
+
+// LoadFile reads the config file at path. A missing file yields an empty document.
+func LoadFile(path string) (*File, error) {
+	file := &File{
+		path: path,
+		root: &yaml.Node{Kind: yaml.MappingNode},
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+
+	if len(document.Content) > 0 {
+		if document.Content[0].Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("config file %s is not a YAML mapping", path)
+		}
+		file.root = document.Content[0]
+	}
+
+	return file, nil
+}
+
+// Path returns the path of the config file
+func (f *File) Path() string {
+	return f.path
+}
+
+// Set sets the value at the given key path, creating intermediate mappings as needed
+func (f *File) Set(keys []string, value interface{}) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("empty key")
+	}
+
+	var valueNode yaml.Node
+	if err := valueNode.Encode(value); err != nil {
+		return fmt.Errorf("error encoding value: %w", err)
+	}
+
+	node := f.root
+	for i, key := range keys {
+		child := mappingValue(node, key)
+		last := i == len(keys)-1
+
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			if last {
+				child = &valueNode
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, child)
+		} else if last {
+			*child = valueNode
+		} else if child.Kind != yaml.MappingNode {
+			return fmt.Errorf("cannot set %s: %s is not a mapping", strings.Join(keys, "."), strings.Join(keys[:i+1], "."))
+		}
+
+		node = child
+	}
+
+	return nil
+}
+
+// Unset removes the value at the given key path. It reports whether the key was set.
+func (f *File) Unset(keys []string) bool {
+	if len(keys) == 0 {
+		return false
+	}
+
+	node := f.root
+	for _, key := range keys[:len(keys)-1] {
+		if node = mappingValue(node, key); node == nil {
+			return false
+		}
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	last := keys[len(keys)-1]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == last {
+			// The leading comment of a mapping, such as the header of the file, is attached to its first key
+			if i == 0 && len(node.Content) > 2 && node.Content[0].HeadComment != "" {
+				next := node.Content[2]
+				next.HeadComment = strings.TrimSuffix(node.Content[0].HeadComment+"\n"+next.HeadComment, "\n")
+			}
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Save writes the config file, creating its directory if needed
+func (f *File) Save() error {
+	var content bytes.Buffer
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(f.root); err != nil {
+		return fmt.Errorf("error encoding config file: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("error encoding config file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+
+	if err := os.WriteFile(f.path, content.Bytes(), 0600); err != nil {
+		return fmt.Errorf("error writing config file: %w", err)
+	}
+
+	return nil
+}
+
+// mappingValue returns the value node of key in a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
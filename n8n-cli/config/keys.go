@@ -0,0 +1,405 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Kind is the type of value a configuration key holds
+type Kind int
+
+// Kinds of configuration values
+const (
+	KindString Kind = iota
+	KindURL
+	KindBool
+	KindInt
+	KindDuration
+	KindList
+	KindMap
+)
+
+// RedactedValue replaces the value of secret keys in the output of the config commands
+const RedactedValue = "********"
+
+// authHeadersKey holds the static headers of the header authenticator, every header is a secret
+const authHeadersKey = "auth.headers"
+
+// Key describes a configuration key known to the CLI
+type Key struct {
+	// Name is the key as written in the config file, nested keys are joined with dots
+	Name string
+	// Kind is the type of the value
+	Kind Kind
+	// Flag is the name of the root flag bound to the key, if any
+	Flag string
+	// Env is the environment variable read for the key, if any
+	Env string
+	// Secret keys are redacted by the config commands
+	Secret bool
+	// Global keys are only allowed at the top level of the config file, not inside a context
+	Global bool
+}
+
+// Keys lists every configuration key known to the CLI
+var Keys = []Key{
+	{Name: "api_key", Kind: KindString, Flag: "api-key", Env: "N8N_API_KEY", Secret: true},
+	{Name: "api_key_command", Kind: KindString, Env: "N8N_API_KEY_COMMAND"},
+	{Name: "instance_url", Kind: KindURL, Flag: "url", Env: "N8N_INSTANCE_URL"},
+	{Name: ContextKey, Kind: KindString, Flag: "context", Env: "N8N_CONTEXT", Global: true},
+	{Name: CurrentContextKey, Kind: KindString, Global: true},
+	{Name: ContextsKey, Kind: KindMap, Global: true},
+	{Name: "enc_key_file", Kind: KindString, Env: "N8N_ENC_KEY_FILE"},
+	{Name: "debug", Kind: KindBool, Flag: "debug", Env: "N8N_DEBUG"},
+	{Name: "timeout", Kind: KindDuration, Flag: "timeout", Env: "N8N_TIMEOUT"},
+	{Name: "retry_max_attempts", Kind: KindInt, Flag: "retry-max-attempts", Env: "N8N_RETRY_MAX_ATTEMPTS"},
+	{Name: "retry_backoff", Kind: KindDuration, Flag: "retry-backoff", Env: "N8N_RETRY_BACKOFF"},
+	{Name: "retry_max_delay", Kind: KindDuration, Env: "N8N_RETRY_MAX_DELAY"},
+	{Name: "retry_non_idempotent", Kind: KindBool, Flag: "retry-non-idempotent", Env: "N8N_RETRY_NON_IDEMPOTENT"},
+	{Name: "ca_file", Kind: KindString, Flag: "ca-file", Env: "N8N_CA_FILE"},
+	{Name: "client_cert", Kind: KindString, Flag: "client-cert", Env: "N8N_CLIENT_CERT"},
+	{Name: "client_key", Kind: KindString, Flag: "client-key", Env: "N8N_CLIENT_KEY"},
+	{Name: "insecure_skip_verify", Kind: KindBool, Flag: "insecure-skip-verify", Env: "N8N_INSECURE_SKIP_VERIFY"},
+	{Name: "proxy_url", Kind: KindURL, Flag: "proxy-url", Env: "N8N_PROXY_URL"},
+	{Name: "auth", Kind: KindMap},
+	{Name: "auth.type", Kind: KindString},
+	{Name: authHeadersKey, Kind: KindMap, Secret: true},
+	{Name: "auth.token", Kind: KindString, Secret: true},
+	{Name: "auth.command", Kind: KindString},
+	{Name: "auth.args", Kind: KindList},
+	{Name: "auth.header", Kind: KindString},
+}
+
+// LookupKey returns the known configuration key with the given name
+func LookupKey(name string) (Key, bool) {
+	name = strings.ToLower(name)
+	for _, key := range Keys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+
+	if strings.HasPrefix(name, authHeadersKey+".") {
+		return Key{Name: name, Kind: KindString, Secret: true}, true
+	}
+
+	return Key{}, false
+}
+
+// ResolveKeyPath parses a key path as accepted by "n8n config set", such as
+// instance_url, auth.token or contexts.prod.instance_url. It returns the key
+// and the path of the key in the config file.
+func ResolveKeyPath(path string) (Key, []string, error) {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		if part == "" {
+			return Key{}, nil, fmt.Errorf("invalid key %q", path)
+		}
+		// Header names keep their case, everything else is case-insensitive like in viper
+		if i < 2 || !strings.EqualFold(strings.Join(parts[i-2:i], "."), authHeadersKey) {
+			parts[i] = strings.ToLower(part)
+		}
+	}
+
+	name := strings.Join(parts, ".")
+	if parts[0] == ContextsKey && len(parts) > 1 {
+		if len(parts) < 3 {
+			return Key{}, nil, fmt.Errorf("key %q is a context, set one of its keys such as %s.instance_url instead", path, name)
+		}
+		name = strings.Join(parts[2:], ".")
+	}
+
+	key, ok := LookupKey(name)
+	if !ok {
+		return Key{}, nil, unknownKeyError(name)
+	}
+	if parts[0] == ContextsKey && len(parts) > 1 && key.Global {
+		return Key{}, nil, fmt.Errorf("key %q cannot be set inside a context", key.Name)
+	}
+
+	return key, parts, nil
+}
+
+// ParseValue converts the string form of a value, as given on the command line,
+// to the type of key and validates it
+func ParseValue(key Key, raw string) (interface{}, error) {
+	var value interface{} = raw
+
+	switch key.Kind {
+	case KindBool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", key.Name)
+		}
+		value = parsed
+	case KindInt:
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer", key.Name)
+		}
+		value = parsed
+	case KindList:
+		items := []string{}
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		value = items
+	case KindMap:
+		return nil, fmt.Errorf("%s is a section, set one of its keys instead", key.Name)
+	}
+
+	if err := ValidateValue(key, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// ValidateValue checks that value is of the kind of key. Values read from
+// environment variables are strings and are parsed the way viper does.
+func ValidateValue(key Key, value interface{}) error {
+	if isEmpty(value) {
+		return nil
+	}
+
+	switch key.Kind {
+	case KindURL:
+		return validateURL(key, value)
+	case KindBool:
+		if s, ok := value.(string); ok {
+			if _, err := strconv.ParseBool(s); err != nil {
+				return fmt.Errorf("%s must be true or false, got %q", key.Name, s)
+			}
+		} else if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be true or false, got %v", key.Name, value)
+		}
+	case KindInt:
+		if s, ok := value.(string); ok {
+			if _, err := strconv.Atoi(s); err != nil {
+				return fmt.Errorf("%s must be an integer, got %q", key.Name, s)
+			}
+		} else if _, ok := value.(int); !ok {
+			return fmt.Errorf("%s must be an integer, got %v", key.Name, value)
+		}
+	case KindDuration:
+		switch v := value.(type) {
+		case time.Duration:
+		case string:
+			if _, err := time.ParseDuration(v); err != nil {
+				return fmt.Errorf("%s must be a duration such as 30s or 1m, got %q", key.Name, v)
+			}
+		default:
+			return fmt.Errorf("%s must be a duration such as 30s or 1m, got %v", key.Name, value)
+		}
+	case KindList:
+		switch value.(type) {
+		case []interface{}, []string, string:
+		default:
+			return fmt.Errorf("%s must be a list, got %v", key.Name, value)
+		}
+	case KindMap:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("%s must be a mapping, got %v", key.Name, value)
+		}
+	case KindString:
+		switch value.(type) {
+		case string, int, float64, bool:
+		default:
+			return fmt.Errorf("%s must be a string, got %v", key.Name, value)
+		}
+		if key.Name == "auth.type" {
+			switch fmt.Sprint(value) {
+			case "header", "bearer", "exec":
+			default:
+				return fmt.Errorf("auth.type must be header, bearer or exec, got %q", value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateSettings checks the settings of a config file for unknown keys and invalid
+// values, including the settings of every context. It returns one error per problem.
+func ValidateSettings(settings map[string]interface{}) []error {
+	var problems []error
+	validateSettings(settings, "", "", false, &problems)
+	return problems
+}
+
+// ValidateEnvironment checks the values given for the known keys through flags,
+// environment variables and the .env file. Config files are checked with ValidateSettings.
+func ValidateEnvironment(flags *pflag.FlagSet) []error {
+	var problems []error
+	envFile := EnvFileValues(DefaultFileReader)
+
+	for _, key := range Keys {
+		if key.Flag != "" && flags != nil && key.Kind == KindURL {
+			if flag := flags.Lookup(key.Flag); flag != nil && flag.Changed {
+				if err := ValidateValue(key, flag.Value.String()); err != nil {
+					problems = append(problems, fmt.Errorf("--%s: %w", flag.Name, err))
+				}
+			}
+		}
+
+		if key.Env != "" {
+			if err := ValidateValue(key, os.Getenv(key.Env)); err != nil {
+				problems = append(problems, fmt.Errorf("%s: %w", key.Env, err))
+			}
+		}
+
+		if value, ok := envFile[key.Name]; ok && !IsEncrypted(value) {
+			if err := ValidateValue(key, value); err != nil {
+				problems = append(problems, fmt.Errorf(".env: %w", err))
+			}
+		}
+	}
+
+	return problems
+}
+
+func validateSettings(settings map[string]interface{}, keyPrefix, pathPrefix string, inContext bool, problems *[]error) {
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := settings[name]
+		path := pathPrefix + name
+		keyName := strings.ToLower(keyPrefix + name)
+
+		key, ok := LookupKey(keyName)
+		if !ok {
+			*problems = append(*problems, fmt.Errorf("%s: %w", path, unknownKeyError(keyName)))
+			continue
+		}
+		if inContext && key.Global {
+			*problems = append(*problems, fmt.Errorf("%s: key %q cannot be set inside a context", path, key.Name))
+			continue
+		}
+		if err := ValidateValue(key, value); err != nil {
+			*problems = append(*problems, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		children, isMap := value.(map[string]interface{})
+		switch {
+		case !isMap || key.Name == authHeadersKey:
+		case key.Name == ContextsKey:
+			for _, contextName := range sortedKeys(children) {
+				contextPath := path + "." + contextName + "."
+				contextSettings, ok := children[contextName].(map[string]interface{})
+				if !ok {
+					if children[contextName] != nil {
+						*problems = append(*problems, fmt.Errorf("%s: context must be a mapping", strings.TrimSuffix(contextPath, ".")))
+					}
+					continue
+				}
+				validateSettings(contextSettings, "", contextPath, true, problems)
+			}
+		default:
+			validateSettings(children, key.Name+".", path+".", inContext, problems)
+		}
+	}
+}
+
+// Redact returns value with the values of secret keys replaced by RedactedValue.
+// name is the key of value, or empty for the whole configuration.
+func Redact(name string, value interface{}) interface{} {
+	settings, ok := value.(map[string]interface{})
+	if !ok {
+		if key, known := LookupKey(name); known && key.Secret && !isEmpty(value) {
+			return RedactedValue
+		}
+		return value
+	}
+
+	redacted := make(map[string]interface{}, len(settings))
+	for child, childValue := range settings {
+		switch name {
+		case "":
+			redacted[child] = Redact(child, childValue)
+		case ContextsKey:
+			// Contexts hold the same keys as the top level
+			redacted[child] = Redact("", childValue)
+		default:
+			redacted[child] = Redact(name+"."+child, childValue)
+		}
+	}
+
+	return redacted
+}
+
+// validateURL checks that value is an absolute URL with a scheme supported by key
+func validateURL(key Key, value interface{}) error {
+	raw, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%s must be a URL, got %v", key.Name, value)
+	}
+
+	schemes := []string{"http", "https"}
+	if key.Name == "proxy_url" {
+		schemes = append(schemes, "socks5")
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid URL: %v", key.Name, err)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("%s must be an absolute URL such as https://n8n.example.com, got %q", key.Name, raw)
+	}
+	for _, scheme := range schemes {
+		if parsed.Scheme == scheme {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s must use the %s scheme, got %q", key.Name, strings.Join(schemes, ", "), raw)
+}
+
+// unknownKeyError reports an unknown key, suggesting the known key it was probably meant to be
+func unknownKeyError(name string) error {
+	if key, ok := LookupKey(strings.ReplaceAll(name, "-", "_")); ok && key.Name != name {
+		return fmt.Errorf("unknown configuration key %q, did you mean %q?", name, key.Name)
+	}
+	return fmt.Errorf("unknown configuration key %q", name)
+}
+
+// isEmpty reports whether value is nil, an empty string or an empty collection
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Map, reflect.Slice:
+		return rv.Len() == 0
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of m in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -77,3 +77,11 @@ func Fatal(format string, args ...interface{}) {
 		logger.Fatalf(format, args...)
 	}
 }
+
+// Get returns the global logger, or a no-op logger if InitLogger was not called
+func Get() *zap.SugaredLogger {
+	if logger == nil {
+		return zap.NewNop().Sugar()
+	}
+	return logger
+}
@@ -0,0 +1,154 @@
+package n8n
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds authentication to outgoing API requests. The client
+// always sends the X-N8N-API-KEY header; authenticators add whatever an API
+// gateway in front of the instance requires on top of it.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// Invalidator is implemented by authenticators that cache credentials. The client
+// calls Invalidate when the instance answers 401 Unauthorized, so a credential
+// revoked before it expired is obtained again.
+type Invalidator interface {
+	Invalidate()
+}
+
+// HeaderAuth sets a static set of headers, e.g. a Cloudflare Access service token
+type HeaderAuth map[string]string
+
+// Authenticate implements Authenticator
+func (a HeaderAuth) Authenticate(req *http.Request) error {
+	for key, value := range a {
+		req.Header.Set(key, value)
+	}
+	return nil
+}
+
+// BearerTokenAuth sets a static bearer token in the Authorization header
+type BearerTokenAuth string
+
+// Authenticate implements Authenticator
+func (a BearerTokenAuth) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(a))
+	return nil
+}
+
+// execTokenExpirySkew refreshes exec tokens slightly before they expire
+const execTokenExpirySkew = 30 * time.Second
+
+// ExecTokenAuth obtains a token by running a command and caches it until it
+// expires or the instance rejects it. The command prints either the bare token, or a JSON object with a
+// "token" field and an optional RFC 3339 "expiry" field. Tokens without an
+// expiry are cached for the lifetime of the process.
+type ExecTokenAuth struct {
+	// Command is the executable to run
+	Command string
+	// Args are the arguments passed to Command
+	Args []string
+	// Header receives the token. When empty, the token is sent as a bearer
+	// token in the Authorization header.
+	Header string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewExecTokenAuth creates an authenticator running command with args
+func NewExecTokenAuth(command string, args ...string) *ExecTokenAuth {
+	return &ExecTokenAuth{Command: command, Args: args}
+}
+
+// Authenticate implements Authenticator
+func (a *ExecTokenAuth) Authenticate(req *http.Request) error {
+	token, err := a.Token(req.Context())
+	if err != nil {
+		return err
+	}
+
+	if a.Header == "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.Header.Set(a.Header, token)
+	}
+	return nil
+}
+
+// Token returns the cached token, running the command when there is no token
+// yet or the cached one is about to expire
+func (a *ExecTokenAuth) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && (a.expiry.IsZero() || time.Now().Add(execTokenExpirySkew).Before(a.expiry)) {
+		return a.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	command := exec.CommandContext(ctx, a.Command, a.Args...)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	if err := command.Run(); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return "", fmt.Errorf("token command %s failed: %w: %s", a.Command, err, detail)
+		}
+		return "", fmt.Errorf("token command %s failed: %w", a.Command, err)
+	}
+
+	token, expiry, err := parseExecToken(stdout.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("token command %s: %w", a.Command, err)
+	}
+
+	a.token = token
+	a.expiry = expiry
+	return token, nil
+}
+
+// Invalidate implements Invalidator, the next request runs the command again
+func (a *ExecTokenAuth) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.token = ""
+	a.expiry = time.Time{}
+}
+
+// parseExecToken parses the output of a token command
+func parseExecToken(output []byte) (string, time.Time, error) {
+	trimmed := bytes.TrimSpace(output)
+	if len(trimmed) == 0 {
+		return "", time.Time{}, fmt.Errorf("printed no token")
+	}
+
+	if trimmed[0] != '{' {
+		return string(trimmed), time.Time{}, nil
+	}
+
+	var payload struct {
+		Token  string    `json:"token"`
+		Expiry time.Time `json:"expiry"`
+	}
+	if err := json.Unmarshal(trimmed, &payload); err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid JSON output: %w", err)
+	}
+	if payload.Token == "" {
+		return "", time.Time{}, fmt.Errorf("printed no token")
+	}
+
+	return payload.Token, payload.Expiry, nil
+}
@@ -2,52 +2,108 @@ package n8n
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 )
 
 // Client is a simple client for interacting with n8n API
 type Client struct {
-	baseURL  string
-	apiToken string
-	client   *http.Client
-	logger   *zap.SugaredLogger
+	baseURL     string
+	apiPath     string
+	apiToken    string
+	userAgent   string
+	headers     http.Header
+	auth        []Authenticator
+	timeout     time.Duration
+	client      *http.Client
+	logger      *zap.SugaredLogger
+	retryPolicy RetryPolicy
 }
 
-// NewClient creates a new n8n client
-func NewClient(baseURL, apiToken string) *Client {
-	var logger *zap.SugaredLogger
+// NewClient creates a new n8n client for the instance at baseURL. The API path
+// is appended to baseURL unless it already ends with it.
+func NewClient(baseURL, apiToken string, opts ...Option) *Client {
+	c := &Client{
+		apiPath:     DefaultAPIPath,
+		apiToken:    apiToken,
+		userAgent:   DefaultUserAgent,
+		headers:     http.Header{},
+		client:      &http.Client{},
+		logger:      zap.NewNop().Sugar(),
+		retryPolicy: DefaultRetryPolicy(),
+	}
 
-	if os.Getenv("DEBUG") == "1" || os.Getenv("DEBUG") == "true" {
-		cfg := zap.NewDevelopmentConfig()
-		cfg.EncoderConfig.TimeKey = "time"
-		cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	for _, opt := range opts {
+		opt(c)
+	}
 
-		zapLogger, err := cfg.Build()
-		if err != nil {
-			zapLogger, _ = zap.NewProduction()
-		}
+	if c.timeout > 0 {
+		httpClient := *c.client
+		httpClient.Timeout = c.timeout
+		c.client = &httpClient
+	}
+
+	c.baseURL = joinAPIPath(baseURL, c.apiPath)
+
+	return c
+}
+
+// BaseURL returns the URL that API paths are resolved against
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// joinAPIPath appends apiPath to instanceURL unless it is already there
+func joinAPIPath(instanceURL, apiPath string) string {
+	instanceURL = strings.TrimSuffix(instanceURL, "/")
+	apiPath = strings.TrimSuffix(apiPath, "/")
+	if apiPath == "" {
+		return instanceURL
+	}
+	if !strings.HasPrefix(apiPath, "/") {
+		apiPath = "/" + apiPath
+	}
+
+	if strings.HasSuffix(instanceURL, apiPath) {
+		return instanceURL
+	}
+
+	return instanceURL + apiPath
+}
+
+// newRequest creates a request carrying the custom headers, user agent and
+// API key of the client, then applies the configured authenticators
+func (c *Client) newRequest(ctx context.Context, method, rawURL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
 
-		logger = zapLogger.Sugar().Named("n8n-api")
-	} else {
-		zapLogger, _ := zap.NewProduction()
-		logger = zapLogger.Sugar().Named("n8n-api")
+	for key, values := range c.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
+	req.Header.Set("X-N8N-API-KEY", c.apiToken)
 
-	return &Client{
-		baseURL:  baseURL + "/api/v1",
-		apiToken: apiToken,
-		client:   &http.Client{},
-		logger:   logger,
+	for _, auth := range c.auth {
+		if err := auth.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("error authenticating request: %w", err)
+		}
 	}
+
+	return req, nil
 }
 
 // logDebug logs a debug message
@@ -56,9 +112,8 @@ func (c *Client) logDebug(format string, args ...interface{}) {
 }
 
 // GetWorkflows fetches workflows from the n8n API
-func (c *Client) GetWorkflows() (*WorkflowList, error) {
+func (c *Client) GetWorkflows(ctx context.Context) (*WorkflowList, error) {
 	baseURL := fmt.Sprintf("%s/workflows", c.baseURL)
-	const pageLimit = 100
 
 	var all []Workflow
 	seenCursors := make(map[string]struct{})
@@ -76,15 +131,12 @@ func (c *Client) GetWorkflows() (*WorkflowList, error) {
 			requestURL = fmt.Sprintf("%s?%s", baseURL, params.Encode())
 		}
 
-		req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+		req, err := c.newRequest(ctx, http.MethodGet, requestURL, nil)
 		if err != nil {
 			return nil, err
 		}
 
-		req.Header.Set("X-N8N-API-KEY", c.apiToken)
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := c.client.Do(req)
+		resp, err := c.do(req)
 		if err != nil {
 			return nil, err
 		}
@@ -97,7 +149,7 @@ func (c *Client) GetWorkflows() (*WorkflowList, error) {
 
 			if resp.StatusCode != http.StatusOK {
 				body, _ := io.ReadAll(resp.Body)
-				err = fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+				err = newAPIError(resp, body)
 				return
 			}
 
@@ -138,18 +190,15 @@ func (c *Client) GetWorkflows() (*WorkflowList, error) {
 }
 
 // ActivateWorkflow activates a workflow by ID
-func (c *Client) ActivateWorkflow(id string) (*Workflow, error) {
+func (c *Client) ActivateWorkflow(ctx context.Context, id string) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows/%s/activate", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodPost, url, nil)
+	req, err := c.newRequest(ctx, http.MethodPost, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +210,7 @@ func (c *Client) ActivateWorkflow(id string) (*Workflow, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var result Workflow
@@ -173,18 +222,15 @@ func (c *Client) ActivateWorkflow(id string) (*Workflow, error) {
 }
 
 // DeactivateWorkflow deactivates a workflow by ID
-func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
+func (c *Client) DeactivateWorkflow(ctx context.Context, id string) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows/%s/deactivate", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodPost, url, nil)
+	req, err := c.newRequest(ctx, http.MethodPost, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +242,7 @@ func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var result Workflow
@@ -208,7 +254,7 @@ func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
 }
 
 // CreateWorkflow creates a new workflow
-func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
+func (c *Client) CreateWorkflow(ctx context.Context, workflow *Workflow) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows", c.baseURL)
 
 	workflowCopy := *workflow
@@ -230,15 +276,12 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 		c.logDebug("CREATE WORKFLOW FORMATTED JSON:\n%s", prettyJSON.String())
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +301,7 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 	c.logDebug("CREATE/UPDATE WORKFLOW RESPONSE (Status: %d): %s", resp.StatusCode, string(respBody))
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, respBody)
+		return nil, newAPIError(resp, respBody)
 	}
 
 	var w Workflow
@@ -270,7 +313,7 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 }
 
 // UpdateWorkflow updates an existing workflow by its ID
-func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error) {
+func (c *Client) UpdateWorkflow(ctx context.Context, id string, workflow *Workflow) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows/%s", c.baseURL, id)
 
 	workflowCopy := *workflow
@@ -292,15 +335,12 @@ func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error
 		c.logDebug("UPDATE WORKFLOW FORMATTED JSON (ID: %s):\n%s", id, prettyJSON.String())
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -312,7 +352,7 @@ func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var w Workflow
@@ -324,18 +364,15 @@ func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error
 }
 
 // GetWorkflow fetches a single workflow by its ID
-func (c *Client) GetWorkflow(id string) (*Workflow, error) {
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows/%s", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +384,7 @@ func (c *Client) GetWorkflow(id string) (*Workflow, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var workflow Workflow
@@ -359,17 +396,15 @@ func (c *Client) GetWorkflow(id string) (*Workflow, error) {
 }
 
 // DeleteWorkflow deletes a workflow by ID
-func (c *Client) DeleteWorkflow(id string) error {
+func (c *Client) DeleteWorkflow(ctx context.Context, id string) error {
 	url := fmt.Sprintf("%s/workflows/%s", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
@@ -381,14 +416,14 @@ func (c *Client) DeleteWorkflow(id string) error {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return newAPIError(resp, body)
 	}
 
 	return nil
 }
 
 // CreateCredential creates a new credential
-func (c *Client) CreateCredential(credential *Credential) (*CreateCredentialResponse, error) {
+func (c *Client) CreateCredential(ctx context.Context, credential *Credential) (*CreateCredentialResponse, error) {
 	url := fmt.Sprintf("%s/credentials", c.baseURL)
 
 	payload := Credential{
@@ -402,15 +437,12 @@ func (c *Client) CreateCredential(credential *Credential) (*CreateCredentialResp
 		return nil, fmt.Errorf("error marshaling credential: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +454,7 @@ func (c *Client) CreateCredential(credential *Credential) (*CreateCredentialResp
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var created CreateCredentialResponse
@@ -433,18 +465,53 @@ func (c *Client) CreateCredential(credential *Credential) (*CreateCredentialResp
 	return &created, nil
 }
 
-// DeleteCredential deletes a credential by ID
-func (c *Client) DeleteCredential(id string) (*Credential, error) {
+// UpdateCredential updates the name and data of a credential by its ID
+func (c *Client) UpdateCredential(ctx context.Context, id string, update *CredentialUpdate) (*CreateCredentialResponse, error) {
 	url := fmt.Sprintf("%s/credentials/%s", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	body, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling credential: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPatch, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, body)
+	}
+
+	var updated CreateCredentialResponse
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteCredential deletes a credential by ID
+func (c *Client) DeleteCredential(ctx context.Context, id string) (*Credential, error) {
+	url := fmt.Sprintf("%s/credentials/%s", c.baseURL, id)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +523,7 @@ func (c *Client) DeleteCredential(id string) (*Credential, error) {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	if resp.StatusCode == http.StatusNoContent {
@@ -472,18 +539,15 @@ func (c *Client) DeleteCredential(id string) (*Credential, error) {
 }
 
 // GetCredentialSchema fetches the schema for a credential type
-func (c *Client) GetCredentialSchema(credentialTypeName string) (map[string]interface{}, error) {
+func (c *Client) GetCredentialSchema(ctx context.Context, credentialTypeName string) (map[string]interface{}, error) {
 	url := fmt.Sprintf("%s/credentials/schema/%s", c.baseURL, credentialTypeName)
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -495,7 +559,7 @@ func (c *Client) GetCredentialSchema(credentialTypeName string) (map[string]inte
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var schema map[string]interface{}
@@ -507,7 +571,7 @@ func (c *Client) GetCredentialSchema(credentialTypeName string) (map[string]inte
 }
 
 // TransferCredential transfers a credential to another project
-func (c *Client) TransferCredential(id string, destinationProjectId string) error {
+func (c *Client) TransferCredential(ctx context.Context, id string, destinationProjectId string) error {
 	url := fmt.Sprintf("%s/credentials/%s/transfer", c.baseURL, id)
 
 	body, err := json.Marshal(PutCredentialsIdTransferJSONBody{
@@ -517,15 +581,46 @@ func (c *Client) TransferCredential(id string, destinationProjectId string) erro
 		return fmt.Errorf("error marshaling transfer request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// TransferWorkflow transfers a workflow to another project
+func (c *Client) TransferWorkflow(ctx context.Context, id string, destinationProjectId string) error {
+	url := fmt.Sprintf("%s/workflows/%s/transfer", c.baseURL, id)
+
+	body, err := json.Marshal(PutWorkflowsIdTransferJSONBody{
+		DestinationProjectId: destinationProjectId,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling transfer request: %w", err)
+	}
 
-	resp, err := c.client.Do(req)
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
@@ -537,7 +632,7 @@ func (c *Client) TransferCredential(id string, destinationProjectId string) erro
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return newAPIError(resp, body)
 	}
 
 	return nil
@@ -549,7 +644,7 @@ func (c *Client) TransferCredential(id string, destinationProjectId string) erro
 // status is optional - if provided, only executions with that status will be returned (error, success, waiting)
 // limit is optional - if provided, limits the number of executions returned
 // cursor is optional - if provided, retrieves the next page of results
-func (c *Client) GetExecutions(workflowID string, includeData bool, status string, limit int, cursor string) (*ExecutionList, error) {
+func (c *Client) GetExecutions(ctx context.Context, workflowID string, includeData bool, status string, limit int, cursor string) (*ExecutionList, error) {
 	baseURL := fmt.Sprintf("%s/executions", c.baseURL)
 
 	params := url.Values{}
@@ -574,15 +669,12 @@ func (c *Client) GetExecutions(workflowID string, includeData bool, status strin
 		requestURL = fmt.Sprintf("%s?%s", baseURL, params.Encode())
 	}
 
-	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -594,7 +686,7 @@ func (c *Client) GetExecutions(workflowID string, includeData bool, status strin
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var flexibleResult ExecutionListWithFlexibleIDs
@@ -608,7 +700,7 @@ func (c *Client) GetExecutions(workflowID string, includeData bool, status strin
 
 // GetExecutionById fetches a specific execution by its ID
 // includeData is optional - if provided as true, execution data will be included in the response
-func (c *Client) GetExecutionById(executionID string, includeData bool) (*Execution, error) {
+func (c *Client) GetExecutionById(ctx context.Context, executionID string, includeData bool) (*Execution, error) {
 	baseURL := fmt.Sprintf("%s/executions/%s", c.baseURL, executionID)
 
 	params := url.Values{}
@@ -621,15 +713,12 @@ func (c *Client) GetExecutionById(executionID string, includeData bool) (*Execut
 		requestURL = fmt.Sprintf("%s?%s", baseURL, params.Encode())
 	}
 
-	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -641,7 +730,47 @@ func (c *Client) GetExecutionById(executionID string, includeData bool) (*Execut
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
+	}
+
+	var flexibleResult ExecutionWithFlexibleIDs
+	if err := json.NewDecoder(resp.Body).Decode(&flexibleResult); err != nil {
+		return nil, fmt.Errorf("failed to decode execution: %v", err)
+	}
+
+	result := toExecution(flexibleResult)
+	return &result, nil
+}
+
+// RetryExecution retries a failed execution and returns the new execution.
+// loadWorkflow runs the retry with the currently saved workflow instead of the
+// workflow as it was when the execution started
+func (c *Client) RetryExecution(ctx context.Context, executionID string, loadWorkflow bool) (*Execution, error) {
+	url := fmt.Sprintf("%s/executions/%s/retry", c.baseURL, executionID)
+
+	body, err := json.Marshal(PostExecutionsIdRetryJSONBody{LoadWorkflow: &loadWorkflow})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling retry request: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, respBody)
 	}
 
 	var flexibleResult ExecutionWithFlexibleIDs
@@ -654,18 +783,15 @@ func (c *Client) GetExecutionById(executionID string, includeData bool) (*Execut
 }
 
 // GetWorkflowTags fetches the tags of a workflow by its ID
-func (c *Client) GetWorkflowTags(id string) (WorkflowTags, error) {
+func (c *Client) GetWorkflowTags(ctx context.Context, id string) (WorkflowTags, error) {
 	url := fmt.Sprintf("%s/workflows/%s/tags", c.baseURL, id)
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -677,7 +803,7 @@ func (c *Client) GetWorkflowTags(id string) (WorkflowTags, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var tags WorkflowTags
@@ -689,7 +815,7 @@ func (c *Client) GetWorkflowTags(id string) (WorkflowTags, error) {
 }
 
 // UpdateWorkflowTags updates the tags of a workflow by its ID
-func (c *Client) UpdateWorkflowTags(id string, tagIds TagIds) (WorkflowTags, error) {
+func (c *Client) UpdateWorkflowTags(ctx context.Context, id string, tagIds TagIds) (WorkflowTags, error) {
 	url := fmt.Sprintf("%s/workflows/%s/tags", c.baseURL, id)
 
 	jsonBody, err := json.Marshal(tagIds)
@@ -704,15 +830,12 @@ func (c *Client) UpdateWorkflowTags(id string, tagIds TagIds) (WorkflowTags, err
 		c.logDebug("UPDATE WORKFLOW TAGS FORMATTED JSON (ID: %s):\n%s", id, prettyJSON.String())
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonBody))
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -724,7 +847,7 @@ func (c *Client) UpdateWorkflowTags(id string, tagIds TagIds) (WorkflowTags, err
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var tags WorkflowTags
@@ -736,7 +859,7 @@ func (c *Client) UpdateWorkflowTags(id string, tagIds TagIds) (WorkflowTags, err
 }
 
 // CreateTag creates a new tag in n8n
-func (c *Client) CreateTag(tagName string) (*Tag, error) {
+func (c *Client) CreateTag(ctx context.Context, tagName string) (*Tag, error) {
 	url := fmt.Sprintf("%s/tags", c.baseURL)
 
 	tagRequest := map[string]string{"name": tagName}
@@ -747,15 +870,12 @@ func (c *Client) CreateTag(tagName string) (*Tag, error) {
 
 	c.logDebug("CREATE TAG REQUEST: %s", string(jsonBody))
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody))
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -767,7 +887,7 @@ func (c *Client) CreateTag(tagName string) (*Tag, error) {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return nil, newAPIError(resp, body)
 	}
 
 	var tag Tag
@@ -778,19 +898,31 @@ func (c *Client) CreateTag(tagName string) (*Tag, error) {
 	return &tag, nil
 }
 
-// GetTags fetches all tags from n8n
-func (c *Client) GetTags() (*TagList, error) {
-	url := fmt.Sprintf("%s/tags", c.baseURL)
-
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+// GetTags fetches all tags from n8n, following the pagination cursor
+func (c *Client) GetTags(ctx context.Context) (*TagList, error) {
+	tags, err := getAllPages[Tag](ctx, c, fmt.Sprintf("%s/tags", c.baseURL), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("X-N8N-API-KEY", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
+	return &TagList{Data: &tags}, nil
+}
+
+// UpdateTag renames a tag by its ID
+func (c *Client) UpdateTag(ctx context.Context, id string, name string) (*Tag, error) {
+	url := fmt.Sprintf("%s/tags/%s", c.baseURL, id)
+
+	body, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling tag: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -801,14 +933,584 @@ func (c *Client) GetTags() (*TagList, error) {
 	}()
 
 	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, respBody)
+	}
+
+	var tag Tag
+	if err := json.NewDecoder(resp.Body).Decode(&tag); err != nil {
+		return nil, fmt.Errorf("error decoding tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+// DeleteTag deletes a tag by its ID, which also removes it from every workflow
+func (c *Client) DeleteTag(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/tags/%s", c.baseURL, id)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, body)
+		return newAPIError(resp, body)
 	}
 
-	var result TagList
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	return nil
+}
+
+// GetVariables fetches all variables from n8n, following the pagination cursor
+func (c *Client) GetVariables(ctx context.Context) (*VariableList, error) {
+	variables, err := getAllPages[Variable](ctx, c, fmt.Sprintf("%s/variables", c.baseURL), nil)
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
+	return &VariableList{Data: &variables}, nil
+}
+
+// CreateVariable creates a new variable. The API does not return the created variable.
+func (c *Client) CreateVariable(ctx context.Context, variable *Variable) error {
+	url := fmt.Sprintf("%s/variables", c.baseURL)
+
+	body, err := json.Marshal(Variable{Key: variable.Key, Value: variable.Value})
+	if err != nil {
+		return fmt.Errorf("error marshaling variable: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// UpdateVariable replaces the key and value of a variable by its ID
+func (c *Client) UpdateVariable(ctx context.Context, id string, variable *Variable) error {
+	url := fmt.Sprintf("%s/variables/%s", c.baseURL, id)
+
+	body, err := json.Marshal(Variable{Key: variable.Key, Value: variable.Value})
+	if err != nil {
+		return fmt.Errorf("error marshaling variable: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// DeleteVariable deletes a variable by its ID
+func (c *Client) DeleteVariable(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/variables/%s", c.baseURL, id)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// GetProjects fetches all projects from n8n
+func (c *Client) GetProjects(ctx context.Context) (*ProjectList, error) {
+	projects, err := getAllPages[Project](ctx, c, fmt.Sprintf("%s/projects", c.baseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectList{Data: &projects}, nil
+}
+
+// CreateProject creates a new team project. Instances that do not return the
+// created project in the response yield a project without an ID.
+func (c *Client) CreateProject(ctx context.Context, name string) (*Project, error) {
+	url := fmt.Sprintf("%s/projects", c.baseURL)
+
+	body, err := json.Marshal(Project{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling project: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, respBody)
+	}
+
+	project := Project{Name: name}
+	if len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, &project); err != nil {
+			return nil, fmt.Errorf("error decoding project: %w", err)
+		}
+	}
+
+	return &project, nil
+}
+
+// UpdateProject renames a project by its ID
+func (c *Client) UpdateProject(ctx context.Context, id string, name string) error {
+	url := fmt.Sprintf("%s/projects/%s", c.baseURL, id)
+
+	body, err := json.Marshal(Project{Name: name})
+	if err != nil {
+		return fmt.Errorf("error marshaling project: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// DeleteProject deletes a project by its ID
+func (c *Client) DeleteProject(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/projects/%s", c.baseURL, id)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// GetProjectMembers fetches the users of a project. The API has no endpoint
+// listing project members, so the users collection is filtered by the project.
+func (c *Client) GetProjectMembers(ctx context.Context, projectID string) (*UserList, error) {
+	params := url.Values{}
+	params.Set("projectId", projectID)
+	params.Set("includeRole", "true")
+
+	users, err := getAllPages[User](ctx, c, fmt.Sprintf("%s/users", c.baseURL), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserList{Data: &users}, nil
+}
+
+// AddProjectMembers adds users to a project with the given project roles
+func (c *Client) AddProjectMembers(ctx context.Context, projectID string, relations []ProjectRelation) error {
+	url := fmt.Sprintf("%s/projects/%s/users", c.baseURL, projectID)
+
+	body, err := json.Marshal(struct {
+		Relations []ProjectRelation `json:"relations"`
+	}{Relations: relations})
+	if err != nil {
+		return fmt.Errorf("error marshaling project members: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// RemoveProjectMember removes a user from a project
+func (c *Client) RemoveProjectMember(ctx context.Context, projectID string, userID string) error {
+	url := fmt.Sprintf("%s/projects/%s/users/%s", c.baseURL, projectID, userID)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// GetUsers fetches all users of the instance, including their global role and pending invitations
+func (c *Client) GetUsers(ctx context.Context) (*UserList, error) {
+	params := url.Values{}
+	params.Set("includeRole", "true")
+
+	users, err := getAllPages[User](ctx, c, fmt.Sprintf("%s/users", c.baseURL), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserList{Data: &users}, nil
+}
+
+// InviteUsers invites users to the instance and returns one invitation result per
+// user. A user that could not be invited has a result with Error set.
+func (c *Client) InviteUsers(ctx context.Context, invites []UserInvite) ([]UserInvitation, error) {
+	url := fmt.Sprintf("%s/users", c.baseURL)
+
+	body, err := json.Marshal(invites)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling user invitations: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, respBody)
+	}
+
+	// Depending on the version, n8n returns a single result or one result per user
+	trimmed := bytes.TrimSpace(respBody)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var invitation UserInvitation
+		if err := json.Unmarshal(trimmed, &invitation); err != nil {
+			return nil, fmt.Errorf("error decoding user invitations: %w", err)
+		}
+		return []UserInvitation{invitation}, nil
+	}
+
+	var invitations []UserInvitation
+	if err := json.Unmarshal(trimmed, &invitations); err != nil {
+		return nil, fmt.Errorf("error decoding user invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// ChangeUserRole changes the global role of a user by its ID or email address
+func (c *Client) ChangeUserRole(ctx context.Context, idOrEmail string, role string) error {
+	url := fmt.Sprintf("%s/users/%s/role", c.baseURL, idOrEmail)
+
+	body, err := json.Marshal(PatchUsersIdRoleJSONBody{NewRoleName: role})
+	if err != nil {
+		return fmt.Errorf("error marshaling user role: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPatch, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a user by its ID or email address. When transferProjectID is
+// not empty, the workflows and credentials of the user are moved to that project
+// instead of being deleted with the user.
+func (c *Client) DeleteUser(ctx context.Context, idOrEmail string, transferProjectID string) error {
+	requestURL := fmt.Sprintf("%s/users/%s", c.baseURL, idOrEmail)
+	if transferProjectID != "" {
+		requestURL += "?" + url.Values{"transferId": []string{transferProjectID}}.Encode()
+	}
+
+	req, err := c.newRequest(ctx, http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// GenerateAudit generates a security audit of the instance. categories limits the
+// audit to the given risk categories, all categories are audited when it is empty.
+// daysAbandonedWorkflow is the number of days after which a workflow that has not
+// been executed is considered abandoned; the instance default is used when it is 0.
+func (c *Client) GenerateAudit(ctx context.Context, categories []string, daysAbandonedWorkflow int) (*Audit, error) {
+	url := fmt.Sprintf("%s/audit", c.baseURL)
+
+	options := map[string]interface{}{}
+	if len(categories) > 0 {
+		options["categories"] = categories
+	}
+	if daysAbandonedWorkflow > 0 {
+		options["daysAbandonedWorkflow"] = daysAbandonedWorkflow
+	}
+	payload := map[string]interface{}{}
+	if len(options) > 0 {
+		payload["additionalOptions"] = options
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling audit request: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, respBody)
+	}
+
+	// n8n returns an empty array instead of an object when there are no risks
+	var audit Audit
+	if trimmed := bytes.TrimSpace(respBody); len(trimmed) == 0 || trimmed[0] == '[' {
+		return &audit, nil
+	}
+	if err := json.Unmarshal(respBody, &audit); err != nil {
+		return nil, fmt.Errorf("error decoding audit: %w", err)
+	}
+
+	return &audit, nil
+}
+
+// GetWorkflowVersionRefs fetches the current and published version IDs of a workflow
+func (c *Client) GetWorkflowVersionRefs(ctx context.Context, id string) (*WorkflowVersionRefs, error) {
+	url := fmt.Sprintf("%s/workflows/%s", c.baseURL, id)
+
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, body)
+	}
+
+	var refs WorkflowVersionRefs
+	if err := json.NewDecoder(resp.Body).Decode(&refs); err != nil {
+		return nil, err
+	}
+
+	return &refs, nil
+}
+
+// GetWorkflowVersion fetches a version of a workflow from its version history
+func (c *Client) GetWorkflowVersion(ctx context.Context, id string, versionID string) (*WorkflowVersion, error) {
+	url := fmt.Sprintf("%s/workflows/%s/%s", c.baseURL, id, versionID)
+
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, body)
+	}
+
+	var version WorkflowVersion
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
 }
@@ -2,16 +2,18 @@
 package clientfakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/edenreich/n8n-cli/n8n"
 )
 
 type FakeClientInterface struct {
-	ActivateWorkflowStub        func(string) (*n8n.Workflow, error)
+	ActivateWorkflowStub        func(context.Context, string) (*n8n.Workflow, error)
 	activateWorkflowMutex       sync.RWMutex
 	activateWorkflowArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	activateWorkflowReturns struct {
 		result1 *n8n.Workflow
@@ -21,10 +23,37 @@ type FakeClientInterface struct {
 		result1 *n8n.Workflow
 		result2 error
 	}
-	CreateCredentialStub        func(*n8n.Credential) (*n8n.CreateCredentialResponse, error)
+	AddProjectMembersStub        func(context.Context, string, []n8n.ProjectRelation) error
+	addProjectMembersMutex       sync.RWMutex
+	addProjectMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []n8n.ProjectRelation
+	}
+	addProjectMembersReturns struct {
+		result1 error
+	}
+	addProjectMembersReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ChangeUserRoleStub        func(context.Context, string, string) error
+	changeUserRoleMutex       sync.RWMutex
+	changeUserRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	changeUserRoleReturns struct {
+		result1 error
+	}
+	changeUserRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateCredentialStub        func(context.Context, *n8n.Credential) (*n8n.CreateCredentialResponse, error)
 	createCredentialMutex       sync.RWMutex
 	createCredentialArgsForCall []struct {
-		arg1 *n8n.Credential
+		arg1 context.Context
+		arg2 *n8n.Credential
 	}
 	createCredentialReturns struct {
 		result1 *n8n.CreateCredentialResponse
@@ -34,10 +63,25 @@ type FakeClientInterface struct {
 		result1 *n8n.CreateCredentialResponse
 		result2 error
 	}
-	CreateTagStub        func(string) (*n8n.Tag, error)
+	CreateProjectStub        func(context.Context, string) (*n8n.Project, error)
+	createProjectMutex       sync.RWMutex
+	createProjectArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createProjectReturns struct {
+		result1 *n8n.Project
+		result2 error
+	}
+	createProjectReturnsOnCall map[int]struct {
+		result1 *n8n.Project
+		result2 error
+	}
+	CreateTagStub        func(context.Context, string) (*n8n.Tag, error)
 	createTagMutex       sync.RWMutex
 	createTagArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	createTagReturns struct {
 		result1 *n8n.Tag
@@ -47,10 +91,23 @@ type FakeClientInterface struct {
 		result1 *n8n.Tag
 		result2 error
 	}
-	CreateWorkflowStub        func(*n8n.Workflow) (*n8n.Workflow, error)
+	CreateVariableStub        func(context.Context, *n8n.Variable) error
+	createVariableMutex       sync.RWMutex
+	createVariableArgsForCall []struct {
+		arg1 context.Context
+		arg2 *n8n.Variable
+	}
+	createVariableReturns struct {
+		result1 error
+	}
+	createVariableReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateWorkflowStub        func(context.Context, *n8n.Workflow) (*n8n.Workflow, error)
 	createWorkflowMutex       sync.RWMutex
 	createWorkflowArgsForCall []struct {
-		arg1 *n8n.Workflow
+		arg1 context.Context
+		arg2 *n8n.Workflow
 	}
 	createWorkflowReturns struct {
 		result1 *n8n.Workflow
@@ -60,10 +117,11 @@ type FakeClientInterface struct {
 		result1 *n8n.Workflow
 		result2 error
 	}
-	DeactivateWorkflowStub        func(string) (*n8n.Workflow, error)
+	DeactivateWorkflowStub        func(context.Context, string) (*n8n.Workflow, error)
 	deactivateWorkflowMutex       sync.RWMutex
 	deactivateWorkflowArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	deactivateWorkflowReturns struct {
 		result1 *n8n.Workflow
@@ -73,10 +131,11 @@ type FakeClientInterface struct {
 		result1 *n8n.Workflow
 		result2 error
 	}
-	DeleteCredentialStub        func(string) (*n8n.Credential, error)
+	DeleteCredentialStub        func(context.Context, string) (*n8n.Credential, error)
 	deleteCredentialMutex       sync.RWMutex
 	deleteCredentialArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	deleteCredentialReturns struct {
 		result1 *n8n.Credential
@@ -86,10 +145,60 @@ type FakeClientInterface struct {
 		result1 *n8n.Credential
 		result2 error
 	}
-	DeleteWorkflowStub        func(string) error
+	DeleteProjectStub        func(context.Context, string) error
+	deleteProjectMutex       sync.RWMutex
+	deleteProjectArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteProjectReturns struct {
+		result1 error
+	}
+	deleteProjectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTagStub        func(context.Context, string) error
+	deleteTagMutex       sync.RWMutex
+	deleteTagArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteTagReturns struct {
+		result1 error
+	}
+	deleteTagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserStub        func(context.Context, string, string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteVariableStub        func(context.Context, string) error
+	deleteVariableMutex       sync.RWMutex
+	deleteVariableArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteVariableReturns struct {
+		result1 error
+	}
+	deleteVariableReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteWorkflowStub        func(context.Context, string) error
 	deleteWorkflowMutex       sync.RWMutex
 	deleteWorkflowArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	deleteWorkflowReturns struct {
 		result1 error
@@ -97,10 +206,26 @@ type FakeClientInterface struct {
 	deleteWorkflowReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetCredentialSchemaStub        func(string) (map[string]interface{}, error)
+	GenerateAuditStub        func(context.Context, []string, int) (*n8n.Audit, error)
+	generateAuditMutex       sync.RWMutex
+	generateAuditArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 int
+	}
+	generateAuditReturns struct {
+		result1 *n8n.Audit
+		result2 error
+	}
+	generateAuditReturnsOnCall map[int]struct {
+		result1 *n8n.Audit
+		result2 error
+	}
+	GetCredentialSchemaStub        func(context.Context, string) (map[string]interface{}, error)
 	getCredentialSchemaMutex       sync.RWMutex
 	getCredentialSchemaArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getCredentialSchemaReturns struct {
 		result1 map[string]interface{}
@@ -110,11 +235,12 @@ type FakeClientInterface struct {
 		result1 map[string]interface{}
 		result2 error
 	}
-	GetExecutionByIdStub        func(string, bool) (*n8n.Execution, error)
+	GetExecutionByIdStub        func(context.Context, string, bool) (*n8n.Execution, error)
 	getExecutionByIdMutex       sync.RWMutex
 	getExecutionByIdArgsForCall []struct {
-		arg1 string
-		arg2 bool
+		arg1 context.Context
+		arg2 string
+		arg3 bool
 	}
 	getExecutionByIdReturns struct {
 		result1 *n8n.Execution
@@ -124,14 +250,15 @@ type FakeClientInterface struct {
 		result1 *n8n.Execution
 		result2 error
 	}
-	GetExecutionsStub        func(string, bool, string, int, string) (*n8n.ExecutionList, error)
+	GetExecutionsStub        func(context.Context, string, bool, string, int, string) (*n8n.ExecutionList, error)
 	getExecutionsMutex       sync.RWMutex
 	getExecutionsArgsForCall []struct {
-		arg1 string
-		arg2 bool
-		arg3 string
-		arg4 int
-		arg5 string
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+		arg4 string
+		arg5 int
+		arg6 string
 	}
 	getExecutionsReturns struct {
 		result1 *n8n.ExecutionList
@@ -141,9 +268,37 @@ type FakeClientInterface struct {
 		result1 *n8n.ExecutionList
 		result2 error
 	}
-	GetTagsStub        func() (*n8n.TagList, error)
+	GetProjectMembersStub        func(context.Context, string) (*n8n.UserList, error)
+	getProjectMembersMutex       sync.RWMutex
+	getProjectMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getProjectMembersReturns struct {
+		result1 *n8n.UserList
+		result2 error
+	}
+	getProjectMembersReturnsOnCall map[int]struct {
+		result1 *n8n.UserList
+		result2 error
+	}
+	GetProjectsStub        func(context.Context) (*n8n.ProjectList, error)
+	getProjectsMutex       sync.RWMutex
+	getProjectsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getProjectsReturns struct {
+		result1 *n8n.ProjectList
+		result2 error
+	}
+	getProjectsReturnsOnCall map[int]struct {
+		result1 *n8n.ProjectList
+		result2 error
+	}
+	GetTagsStub        func(context.Context) (*n8n.TagList, error)
 	getTagsMutex       sync.RWMutex
 	getTagsArgsForCall []struct {
+		arg1 context.Context
 	}
 	getTagsReturns struct {
 		result1 *n8n.TagList
@@ -153,10 +308,37 @@ type FakeClientInterface struct {
 		result1 *n8n.TagList
 		result2 error
 	}
-	GetWorkflowStub        func(string) (*n8n.Workflow, error)
+	GetUsersStub        func(context.Context) (*n8n.UserList, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		arg1 context.Context
+	}
+	getUsersReturns struct {
+		result1 *n8n.UserList
+		result2 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 *n8n.UserList
+		result2 error
+	}
+	GetVariablesStub        func(context.Context) (*n8n.VariableList, error)
+	getVariablesMutex       sync.RWMutex
+	getVariablesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getVariablesReturns struct {
+		result1 *n8n.VariableList
+		result2 error
+	}
+	getVariablesReturnsOnCall map[int]struct {
+		result1 *n8n.VariableList
+		result2 error
+	}
+	GetWorkflowStub        func(context.Context, string) (*n8n.Workflow, error)
 	getWorkflowMutex       sync.RWMutex
 	getWorkflowArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getWorkflowReturns struct {
 		result1 *n8n.Workflow
@@ -166,10 +348,11 @@ type FakeClientInterface struct {
 		result1 *n8n.Workflow
 		result2 error
 	}
-	GetWorkflowTagsStub        func(string) (n8n.WorkflowTags, error)
+	GetWorkflowTagsStub        func(context.Context, string) (n8n.WorkflowTags, error)
 	getWorkflowTagsMutex       sync.RWMutex
 	getWorkflowTagsArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getWorkflowTagsReturns struct {
 		result1 n8n.WorkflowTags
@@ -179,9 +362,39 @@ type FakeClientInterface struct {
 		result1 n8n.WorkflowTags
 		result2 error
 	}
-	GetWorkflowsStub        func() (*n8n.WorkflowList, error)
+	GetWorkflowVersionStub        func(context.Context, string, string) (*n8n.WorkflowVersion, error)
+	getWorkflowVersionMutex       sync.RWMutex
+	getWorkflowVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getWorkflowVersionReturns struct {
+		result1 *n8n.WorkflowVersion
+		result2 error
+	}
+	getWorkflowVersionReturnsOnCall map[int]struct {
+		result1 *n8n.WorkflowVersion
+		result2 error
+	}
+	GetWorkflowVersionRefsStub        func(context.Context, string) (*n8n.WorkflowVersionRefs, error)
+	getWorkflowVersionRefsMutex       sync.RWMutex
+	getWorkflowVersionRefsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getWorkflowVersionRefsReturns struct {
+		result1 *n8n.WorkflowVersionRefs
+		result2 error
+	}
+	getWorkflowVersionRefsReturnsOnCall map[int]struct {
+		result1 *n8n.WorkflowVersionRefs
+		result2 error
+	}
+	GetWorkflowsStub        func(context.Context) (*n8n.WorkflowList, error)
 	getWorkflowsMutex       sync.RWMutex
 	getWorkflowsArgsForCall []struct {
+		arg1 context.Context
 	}
 	getWorkflowsReturns struct {
 		result1 *n8n.WorkflowList
@@ -191,11 +404,54 @@ type FakeClientInterface struct {
 		result1 *n8n.WorkflowList
 		result2 error
 	}
-	TransferCredentialStub        func(string, string) error
+	InviteUsersStub        func(context.Context, []n8n.UserInvite) ([]n8n.UserInvitation, error)
+	inviteUsersMutex       sync.RWMutex
+	inviteUsersArgsForCall []struct {
+		arg1 context.Context
+		arg2 []n8n.UserInvite
+	}
+	inviteUsersReturns struct {
+		result1 []n8n.UserInvitation
+		result2 error
+	}
+	inviteUsersReturnsOnCall map[int]struct {
+		result1 []n8n.UserInvitation
+		result2 error
+	}
+	RemoveProjectMemberStub        func(context.Context, string, string) error
+	removeProjectMemberMutex       sync.RWMutex
+	removeProjectMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeProjectMemberReturns struct {
+		result1 error
+	}
+	removeProjectMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RetryExecutionStub        func(context.Context, string, bool) (*n8n.Execution, error)
+	retryExecutionMutex       sync.RWMutex
+	retryExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	retryExecutionReturns struct {
+		result1 *n8n.Execution
+		result2 error
+	}
+	retryExecutionReturnsOnCall map[int]struct {
+		result1 *n8n.Execution
+		result2 error
+	}
+	TransferCredentialStub        func(context.Context, string, string) error
 	transferCredentialMutex       sync.RWMutex
 	transferCredentialArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
+		arg3 string
 	}
 	transferCredentialReturns struct {
 		result1 error
@@ -203,11 +459,81 @@ type FakeClientInterface struct {
 	transferCredentialReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateWorkflowStub        func(string, *n8n.Workflow) (*n8n.Workflow, error)
+	TransferWorkflowStub        func(context.Context, string, string) error
+	transferWorkflowMutex       sync.RWMutex
+	transferWorkflowArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	transferWorkflowReturns struct {
+		result1 error
+	}
+	transferWorkflowReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateCredentialStub        func(context.Context, string, *n8n.CredentialUpdate) (*n8n.CreateCredentialResponse, error)
+	updateCredentialMutex       sync.RWMutex
+	updateCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.CredentialUpdate
+	}
+	updateCredentialReturns struct {
+		result1 *n8n.CreateCredentialResponse
+		result2 error
+	}
+	updateCredentialReturnsOnCall map[int]struct {
+		result1 *n8n.CreateCredentialResponse
+		result2 error
+	}
+	UpdateProjectStub        func(context.Context, string, string) error
+	updateProjectMutex       sync.RWMutex
+	updateProjectArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateProjectReturns struct {
+		result1 error
+	}
+	updateProjectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateTagStub        func(context.Context, string, string) (*n8n.Tag, error)
+	updateTagMutex       sync.RWMutex
+	updateTagArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateTagReturns struct {
+		result1 *n8n.Tag
+		result2 error
+	}
+	updateTagReturnsOnCall map[int]struct {
+		result1 *n8n.Tag
+		result2 error
+	}
+	UpdateVariableStub        func(context.Context, string, *n8n.Variable) error
+	updateVariableMutex       sync.RWMutex
+	updateVariableArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.Variable
+	}
+	updateVariableReturns struct {
+		result1 error
+	}
+	updateVariableReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateWorkflowStub        func(context.Context, string, *n8n.Workflow) (*n8n.Workflow, error)
 	updateWorkflowMutex       sync.RWMutex
 	updateWorkflowArgsForCall []struct {
-		arg1 string
-		arg2 *n8n.Workflow
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.Workflow
 	}
 	updateWorkflowReturns struct {
 		result1 *n8n.Workflow
@@ -217,11 +543,12 @@ type FakeClientInterface struct {
 		result1 *n8n.Workflow
 		result2 error
 	}
-	UpdateWorkflowTagsStub        func(string, n8n.TagIds) (n8n.WorkflowTags, error)
+	UpdateWorkflowTagsStub        func(context.Context, string, n8n.TagIds) (n8n.WorkflowTags, error)
 	updateWorkflowTagsMutex       sync.RWMutex
 	updateWorkflowTagsArgsForCall []struct {
-		arg1 string
-		arg2 n8n.TagIds
+		arg1 context.Context
+		arg2 string
+		arg3 n8n.TagIds
 	}
 	updateWorkflowTagsReturns struct {
 		result1 n8n.WorkflowTags
@@ -235,18 +562,19 @@ type FakeClientInterface struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeClientInterface) ActivateWorkflow(arg1 string) (*n8n.Workflow, error) {
+func (fake *FakeClientInterface) ActivateWorkflow(arg1 context.Context, arg2 string) (*n8n.Workflow, error) {
 	fake.activateWorkflowMutex.Lock()
 	ret, specificReturn := fake.activateWorkflowReturnsOnCall[len(fake.activateWorkflowArgsForCall)]
 	fake.activateWorkflowArgsForCall = append(fake.activateWorkflowArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.ActivateWorkflowStub
 	fakeReturns := fake.activateWorkflowReturns
-	fake.recordInvocation("ActivateWorkflow", []interface{}{arg1})
+	fake.recordInvocation("ActivateWorkflow", []interface{}{arg1, arg2})
 	fake.activateWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -260,17 +588,17 @@ func (fake *FakeClientInterface) ActivateWorkflowCallCount() int {
 	return len(fake.activateWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) ActivateWorkflowCalls(stub func(string) (*n8n.Workflow, error)) {
+func (fake *FakeClientInterface) ActivateWorkflowCalls(stub func(context.Context, string) (*n8n.Workflow, error)) {
 	fake.activateWorkflowMutex.Lock()
 	defer fake.activateWorkflowMutex.Unlock()
 	fake.ActivateWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) ActivateWorkflowArgsForCall(i int) string {
+func (fake *FakeClientInterface) ActivateWorkflowArgsForCall(i int) (context.Context, string) {
 	fake.activateWorkflowMutex.RLock()
 	defer fake.activateWorkflowMutex.RUnlock()
 	argsForCall := fake.activateWorkflowArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) ActivateWorkflowReturns(result1 *n8n.Workflow, result2 error) {
@@ -299,18 +627,150 @@ func (fake *FakeClientInterface) ActivateWorkflowReturnsOnCall(i int, result1 *n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) CreateCredential(arg1 *n8n.Credential) (*n8n.CreateCredentialResponse, error) {
+func (fake *FakeClientInterface) AddProjectMembers(arg1 context.Context, arg2 string, arg3 []n8n.ProjectRelation) error {
+	var arg3Copy []n8n.ProjectRelation
+	if arg3 != nil {
+		arg3Copy = make([]n8n.ProjectRelation, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.addProjectMembersMutex.Lock()
+	ret, specificReturn := fake.addProjectMembersReturnsOnCall[len(fake.addProjectMembersArgsForCall)]
+	fake.addProjectMembersArgsForCall = append(fake.addProjectMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []n8n.ProjectRelation
+	}{arg1, arg2, arg3Copy})
+	stub := fake.AddProjectMembersStub
+	fakeReturns := fake.addProjectMembersReturns
+	fake.recordInvocation("AddProjectMembers", []interface{}{arg1, arg2, arg3Copy})
+	fake.addProjectMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) AddProjectMembersCallCount() int {
+	fake.addProjectMembersMutex.RLock()
+	defer fake.addProjectMembersMutex.RUnlock()
+	return len(fake.addProjectMembersArgsForCall)
+}
+
+func (fake *FakeClientInterface) AddProjectMembersCalls(stub func(context.Context, string, []n8n.ProjectRelation) error) {
+	fake.addProjectMembersMutex.Lock()
+	defer fake.addProjectMembersMutex.Unlock()
+	fake.AddProjectMembersStub = stub
+}
+
+func (fake *FakeClientInterface) AddProjectMembersArgsForCall(i int) (context.Context, string, []n8n.ProjectRelation) {
+	fake.addProjectMembersMutex.RLock()
+	defer fake.addProjectMembersMutex.RUnlock()
+	argsForCall := fake.addProjectMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) AddProjectMembersReturns(result1 error) {
+	fake.addProjectMembersMutex.Lock()
+	defer fake.addProjectMembersMutex.Unlock()
+	fake.AddProjectMembersStub = nil
+	fake.addProjectMembersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) AddProjectMembersReturnsOnCall(i int, result1 error) {
+	fake.addProjectMembersMutex.Lock()
+	defer fake.addProjectMembersMutex.Unlock()
+	fake.AddProjectMembersStub = nil
+	if fake.addProjectMembersReturnsOnCall == nil {
+		fake.addProjectMembersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addProjectMembersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) ChangeUserRole(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.changeUserRoleMutex.Lock()
+	ret, specificReturn := fake.changeUserRoleReturnsOnCall[len(fake.changeUserRoleArgsForCall)]
+	fake.changeUserRoleArgsForCall = append(fake.changeUserRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ChangeUserRoleStub
+	fakeReturns := fake.changeUserRoleReturns
+	fake.recordInvocation("ChangeUserRole", []interface{}{arg1, arg2, arg3})
+	fake.changeUserRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) ChangeUserRoleCallCount() int {
+	fake.changeUserRoleMutex.RLock()
+	defer fake.changeUserRoleMutex.RUnlock()
+	return len(fake.changeUserRoleArgsForCall)
+}
+
+func (fake *FakeClientInterface) ChangeUserRoleCalls(stub func(context.Context, string, string) error) {
+	fake.changeUserRoleMutex.Lock()
+	defer fake.changeUserRoleMutex.Unlock()
+	fake.ChangeUserRoleStub = stub
+}
+
+func (fake *FakeClientInterface) ChangeUserRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.changeUserRoleMutex.RLock()
+	defer fake.changeUserRoleMutex.RUnlock()
+	argsForCall := fake.changeUserRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) ChangeUserRoleReturns(result1 error) {
+	fake.changeUserRoleMutex.Lock()
+	defer fake.changeUserRoleMutex.Unlock()
+	fake.ChangeUserRoleStub = nil
+	fake.changeUserRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) ChangeUserRoleReturnsOnCall(i int, result1 error) {
+	fake.changeUserRoleMutex.Lock()
+	defer fake.changeUserRoleMutex.Unlock()
+	fake.ChangeUserRoleStub = nil
+	if fake.changeUserRoleReturnsOnCall == nil {
+		fake.changeUserRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changeUserRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) CreateCredential(arg1 context.Context, arg2 *n8n.Credential) (*n8n.CreateCredentialResponse, error) {
 	fake.createCredentialMutex.Lock()
 	ret, specificReturn := fake.createCredentialReturnsOnCall[len(fake.createCredentialArgsForCall)]
 	fake.createCredentialArgsForCall = append(fake.createCredentialArgsForCall, struct {
-		arg1 *n8n.Credential
-	}{arg1})
+		arg1 context.Context
+		arg2 *n8n.Credential
+	}{arg1, arg2})
 	stub := fake.CreateCredentialStub
 	fakeReturns := fake.createCredentialReturns
-	fake.recordInvocation("CreateCredential", []interface{}{arg1})
+	fake.recordInvocation("CreateCredential", []interface{}{arg1, arg2})
 	fake.createCredentialMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -324,17 +784,17 @@ func (fake *FakeClientInterface) CreateCredentialCallCount() int {
 	return len(fake.createCredentialArgsForCall)
 }
 
-func (fake *FakeClientInterface) CreateCredentialCalls(stub func(*n8n.Credential) (*n8n.CreateCredentialResponse, error)) {
+func (fake *FakeClientInterface) CreateCredentialCalls(stub func(context.Context, *n8n.Credential) (*n8n.CreateCredentialResponse, error)) {
 	fake.createCredentialMutex.Lock()
 	defer fake.createCredentialMutex.Unlock()
 	fake.CreateCredentialStub = stub
 }
 
-func (fake *FakeClientInterface) CreateCredentialArgsForCall(i int) *n8n.Credential {
+func (fake *FakeClientInterface) CreateCredentialArgsForCall(i int) (context.Context, *n8n.Credential) {
 	fake.createCredentialMutex.RLock()
 	defer fake.createCredentialMutex.RUnlock()
 	argsForCall := fake.createCredentialArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) CreateCredentialReturns(result1 *n8n.CreateCredentialResponse, result2 error) {
@@ -363,18 +823,19 @@ func (fake *FakeClientInterface) CreateCredentialReturnsOnCall(i int, result1 *n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) CreateTag(arg1 string) (*n8n.Tag, error) {
-	fake.createTagMutex.Lock()
-	ret, specificReturn := fake.createTagReturnsOnCall[len(fake.createTagArgsForCall)]
-	fake.createTagArgsForCall = append(fake.createTagArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	stub := fake.CreateTagStub
-	fakeReturns := fake.createTagReturns
-	fake.recordInvocation("CreateTag", []interface{}{arg1})
-	fake.createTagMutex.Unlock()
+func (fake *FakeClientInterface) CreateProject(arg1 context.Context, arg2 string) (*n8n.Project, error) {
+	fake.createProjectMutex.Lock()
+	ret, specificReturn := fake.createProjectReturnsOnCall[len(fake.createProjectArgsForCall)]
+	fake.createProjectArgsForCall = append(fake.createProjectArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateProjectStub
+	fakeReturns := fake.createProjectReturns
+	fake.recordInvocation("CreateProject", []interface{}{arg1, arg2})
+	fake.createProjectMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -382,30 +843,95 @@ func (fake *FakeClientInterface) CreateTag(arg1 string) (*n8n.Tag, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClientInterface) CreateTagCallCount() int {
-	fake.createTagMutex.RLock()
-	defer fake.createTagMutex.RUnlock()
-	return len(fake.createTagArgsForCall)
+func (fake *FakeClientInterface) CreateProjectCallCount() int {
+	fake.createProjectMutex.RLock()
+	defer fake.createProjectMutex.RUnlock()
+	return len(fake.createProjectArgsForCall)
 }
 
-func (fake *FakeClientInterface) CreateTagCalls(stub func(string) (*n8n.Tag, error)) {
-	fake.createTagMutex.Lock()
-	defer fake.createTagMutex.Unlock()
-	fake.CreateTagStub = stub
+func (fake *FakeClientInterface) CreateProjectCalls(stub func(context.Context, string) (*n8n.Project, error)) {
+	fake.createProjectMutex.Lock()
+	defer fake.createProjectMutex.Unlock()
+	fake.CreateProjectStub = stub
 }
 
-func (fake *FakeClientInterface) CreateTagArgsForCall(i int) string {
-	fake.createTagMutex.RLock()
-	defer fake.createTagMutex.RUnlock()
-	argsForCall := fake.createTagArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClientInterface) CreateProjectArgsForCall(i int) (context.Context, string) {
+	fake.createProjectMutex.RLock()
+	defer fake.createProjectMutex.RUnlock()
+	argsForCall := fake.createProjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClientInterface) CreateTagReturns(result1 *n8n.Tag, result2 error) {
-	fake.createTagMutex.Lock()
-	defer fake.createTagMutex.Unlock()
-	fake.CreateTagStub = nil
-	fake.createTagReturns = struct {
+func (fake *FakeClientInterface) CreateProjectReturns(result1 *n8n.Project, result2 error) {
+	fake.createProjectMutex.Lock()
+	defer fake.createProjectMutex.Unlock()
+	fake.CreateProjectStub = nil
+	fake.createProjectReturns = struct {
+		result1 *n8n.Project
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) CreateProjectReturnsOnCall(i int, result1 *n8n.Project, result2 error) {
+	fake.createProjectMutex.Lock()
+	defer fake.createProjectMutex.Unlock()
+	fake.CreateProjectStub = nil
+	if fake.createProjectReturnsOnCall == nil {
+		fake.createProjectReturnsOnCall = make(map[int]struct {
+			result1 *n8n.Project
+			result2 error
+		})
+	}
+	fake.createProjectReturnsOnCall[i] = struct {
+		result1 *n8n.Project
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) CreateTag(arg1 context.Context, arg2 string) (*n8n.Tag, error) {
+	fake.createTagMutex.Lock()
+	ret, specificReturn := fake.createTagReturnsOnCall[len(fake.createTagArgsForCall)]
+	fake.createTagArgsForCall = append(fake.createTagArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateTagStub
+	fakeReturns := fake.createTagReturns
+	fake.recordInvocation("CreateTag", []interface{}{arg1, arg2})
+	fake.createTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) CreateTagCallCount() int {
+	fake.createTagMutex.RLock()
+	defer fake.createTagMutex.RUnlock()
+	return len(fake.createTagArgsForCall)
+}
+
+func (fake *FakeClientInterface) CreateTagCalls(stub func(context.Context, string) (*n8n.Tag, error)) {
+	fake.createTagMutex.Lock()
+	defer fake.createTagMutex.Unlock()
+	fake.CreateTagStub = stub
+}
+
+func (fake *FakeClientInterface) CreateTagArgsForCall(i int) (context.Context, string) {
+	fake.createTagMutex.RLock()
+	defer fake.createTagMutex.RUnlock()
+	argsForCall := fake.createTagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) CreateTagReturns(result1 *n8n.Tag, result2 error) {
+	fake.createTagMutex.Lock()
+	defer fake.createTagMutex.Unlock()
+	fake.CreateTagStub = nil
+	fake.createTagReturns = struct {
 		result1 *n8n.Tag
 		result2 error
 	}{result1, result2}
@@ -427,18 +953,81 @@ func (fake *FakeClientInterface) CreateTagReturnsOnCall(i int, result1 *n8n.Tag,
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) CreateWorkflow(arg1 *n8n.Workflow) (*n8n.Workflow, error) {
+func (fake *FakeClientInterface) CreateVariable(arg1 context.Context, arg2 *n8n.Variable) error {
+	fake.createVariableMutex.Lock()
+	ret, specificReturn := fake.createVariableReturnsOnCall[len(fake.createVariableArgsForCall)]
+	fake.createVariableArgsForCall = append(fake.createVariableArgsForCall, struct {
+		arg1 context.Context
+		arg2 *n8n.Variable
+	}{arg1, arg2})
+	stub := fake.CreateVariableStub
+	fakeReturns := fake.createVariableReturns
+	fake.recordInvocation("CreateVariable", []interface{}{arg1, arg2})
+	fake.createVariableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) CreateVariableCallCount() int {
+	fake.createVariableMutex.RLock()
+	defer fake.createVariableMutex.RUnlock()
+	return len(fake.createVariableArgsForCall)
+}
+
+func (fake *FakeClientInterface) CreateVariableCalls(stub func(context.Context, *n8n.Variable) error) {
+	fake.createVariableMutex.Lock()
+	defer fake.createVariableMutex.Unlock()
+	fake.CreateVariableStub = stub
+}
+
+func (fake *FakeClientInterface) CreateVariableArgsForCall(i int) (context.Context, *n8n.Variable) {
+	fake.createVariableMutex.RLock()
+	defer fake.createVariableMutex.RUnlock()
+	argsForCall := fake.createVariableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) CreateVariableReturns(result1 error) {
+	fake.createVariableMutex.Lock()
+	defer fake.createVariableMutex.Unlock()
+	fake.CreateVariableStub = nil
+	fake.createVariableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) CreateVariableReturnsOnCall(i int, result1 error) {
+	fake.createVariableMutex.Lock()
+	defer fake.createVariableMutex.Unlock()
+	fake.CreateVariableStub = nil
+	if fake.createVariableReturnsOnCall == nil {
+		fake.createVariableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createVariableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) CreateWorkflow(arg1 context.Context, arg2 *n8n.Workflow) (*n8n.Workflow, error) {
 	fake.createWorkflowMutex.Lock()
 	ret, specificReturn := fake.createWorkflowReturnsOnCall[len(fake.createWorkflowArgsForCall)]
 	fake.createWorkflowArgsForCall = append(fake.createWorkflowArgsForCall, struct {
-		arg1 *n8n.Workflow
-	}{arg1})
+		arg1 context.Context
+		arg2 *n8n.Workflow
+	}{arg1, arg2})
 	stub := fake.CreateWorkflowStub
 	fakeReturns := fake.createWorkflowReturns
-	fake.recordInvocation("CreateWorkflow", []interface{}{arg1})
+	fake.recordInvocation("CreateWorkflow", []interface{}{arg1, arg2})
 	fake.createWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -452,17 +1041,17 @@ func (fake *FakeClientInterface) CreateWorkflowCallCount() int {
 	return len(fake.createWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) CreateWorkflowCalls(stub func(*n8n.Workflow) (*n8n.Workflow, error)) {
+func (fake *FakeClientInterface) CreateWorkflowCalls(stub func(context.Context, *n8n.Workflow) (*n8n.Workflow, error)) {
 	fake.createWorkflowMutex.Lock()
 	defer fake.createWorkflowMutex.Unlock()
 	fake.CreateWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) CreateWorkflowArgsForCall(i int) *n8n.Workflow {
+func (fake *FakeClientInterface) CreateWorkflowArgsForCall(i int) (context.Context, *n8n.Workflow) {
 	fake.createWorkflowMutex.RLock()
 	defer fake.createWorkflowMutex.RUnlock()
 	argsForCall := fake.createWorkflowArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) CreateWorkflowReturns(result1 *n8n.Workflow, result2 error) {
@@ -491,18 +1080,19 @@ func (fake *FakeClientInterface) CreateWorkflowReturnsOnCall(i int, result1 *n8n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) DeactivateWorkflow(arg1 string) (*n8n.Workflow, error) {
+func (fake *FakeClientInterface) DeactivateWorkflow(arg1 context.Context, arg2 string) (*n8n.Workflow, error) {
 	fake.deactivateWorkflowMutex.Lock()
 	ret, specificReturn := fake.deactivateWorkflowReturnsOnCall[len(fake.deactivateWorkflowArgsForCall)]
 	fake.deactivateWorkflowArgsForCall = append(fake.deactivateWorkflowArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.DeactivateWorkflowStub
 	fakeReturns := fake.deactivateWorkflowReturns
-	fake.recordInvocation("DeactivateWorkflow", []interface{}{arg1})
+	fake.recordInvocation("DeactivateWorkflow", []interface{}{arg1, arg2})
 	fake.deactivateWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -516,17 +1106,17 @@ func (fake *FakeClientInterface) DeactivateWorkflowCallCount() int {
 	return len(fake.deactivateWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) DeactivateWorkflowCalls(stub func(string) (*n8n.Workflow, error)) {
+func (fake *FakeClientInterface) DeactivateWorkflowCalls(stub func(context.Context, string) (*n8n.Workflow, error)) {
 	fake.deactivateWorkflowMutex.Lock()
 	defer fake.deactivateWorkflowMutex.Unlock()
 	fake.DeactivateWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) DeactivateWorkflowArgsForCall(i int) string {
+func (fake *FakeClientInterface) DeactivateWorkflowArgsForCall(i int) (context.Context, string) {
 	fake.deactivateWorkflowMutex.RLock()
 	defer fake.deactivateWorkflowMutex.RUnlock()
 	argsForCall := fake.deactivateWorkflowArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) DeactivateWorkflowReturns(result1 *n8n.Workflow, result2 error) {
@@ -555,18 +1145,19 @@ func (fake *FakeClientInterface) DeactivateWorkflowReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) DeleteCredential(arg1 string) (*n8n.Credential, error) {
+func (fake *FakeClientInterface) DeleteCredential(arg1 context.Context, arg2 string) (*n8n.Credential, error) {
 	fake.deleteCredentialMutex.Lock()
 	ret, specificReturn := fake.deleteCredentialReturnsOnCall[len(fake.deleteCredentialArgsForCall)]
 	fake.deleteCredentialArgsForCall = append(fake.deleteCredentialArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.DeleteCredentialStub
 	fakeReturns := fake.deleteCredentialReturns
-	fake.recordInvocation("DeleteCredential", []interface{}{arg1})
+	fake.recordInvocation("DeleteCredential", []interface{}{arg1, arg2})
 	fake.deleteCredentialMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -580,17 +1171,17 @@ func (fake *FakeClientInterface) DeleteCredentialCallCount() int {
 	return len(fake.deleteCredentialArgsForCall)
 }
 
-func (fake *FakeClientInterface) DeleteCredentialCalls(stub func(string) (*n8n.Credential, error)) {
+func (fake *FakeClientInterface) DeleteCredentialCalls(stub func(context.Context, string) (*n8n.Credential, error)) {
 	fake.deleteCredentialMutex.Lock()
 	defer fake.deleteCredentialMutex.Unlock()
 	fake.DeleteCredentialStub = stub
 }
 
-func (fake *FakeClientInterface) DeleteCredentialArgsForCall(i int) string {
+func (fake *FakeClientInterface) DeleteCredentialArgsForCall(i int) (context.Context, string) {
 	fake.deleteCredentialMutex.RLock()
 	defer fake.deleteCredentialMutex.RUnlock()
 	argsForCall := fake.deleteCredentialArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) DeleteCredentialReturns(result1 *n8n.Credential, result2 error) {
@@ -619,18 +1210,268 @@ func (fake *FakeClientInterface) DeleteCredentialReturnsOnCall(i int, result1 *n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) DeleteWorkflow(arg1 string) error {
+func (fake *FakeClientInterface) DeleteProject(arg1 context.Context, arg2 string) error {
+	fake.deleteProjectMutex.Lock()
+	ret, specificReturn := fake.deleteProjectReturnsOnCall[len(fake.deleteProjectArgsForCall)]
+	fake.deleteProjectArgsForCall = append(fake.deleteProjectArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteProjectStub
+	fakeReturns := fake.deleteProjectReturns
+	fake.recordInvocation("DeleteProject", []interface{}{arg1, arg2})
+	fake.deleteProjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) DeleteProjectCallCount() int {
+	fake.deleteProjectMutex.RLock()
+	defer fake.deleteProjectMutex.RUnlock()
+	return len(fake.deleteProjectArgsForCall)
+}
+
+func (fake *FakeClientInterface) DeleteProjectCalls(stub func(context.Context, string) error) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = stub
+}
+
+func (fake *FakeClientInterface) DeleteProjectArgsForCall(i int) (context.Context, string) {
+	fake.deleteProjectMutex.RLock()
+	defer fake.deleteProjectMutex.RUnlock()
+	argsForCall := fake.deleteProjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) DeleteProjectReturns(result1 error) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = nil
+	fake.deleteProjectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteProjectReturnsOnCall(i int, result1 error) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = nil
+	if fake.deleteProjectReturnsOnCall == nil {
+		fake.deleteProjectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteProjectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteTag(arg1 context.Context, arg2 string) error {
+	fake.deleteTagMutex.Lock()
+	ret, specificReturn := fake.deleteTagReturnsOnCall[len(fake.deleteTagArgsForCall)]
+	fake.deleteTagArgsForCall = append(fake.deleteTagArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTagStub
+	fakeReturns := fake.deleteTagReturns
+	fake.recordInvocation("DeleteTag", []interface{}{arg1, arg2})
+	fake.deleteTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) DeleteTagCallCount() int {
+	fake.deleteTagMutex.RLock()
+	defer fake.deleteTagMutex.RUnlock()
+	return len(fake.deleteTagArgsForCall)
+}
+
+func (fake *FakeClientInterface) DeleteTagCalls(stub func(context.Context, string) error) {
+	fake.deleteTagMutex.Lock()
+	defer fake.deleteTagMutex.Unlock()
+	fake.DeleteTagStub = stub
+}
+
+func (fake *FakeClientInterface) DeleteTagArgsForCall(i int) (context.Context, string) {
+	fake.deleteTagMutex.RLock()
+	defer fake.deleteTagMutex.RUnlock()
+	argsForCall := fake.deleteTagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) DeleteTagReturns(result1 error) {
+	fake.deleteTagMutex.Lock()
+	defer fake.deleteTagMutex.Unlock()
+	fake.DeleteTagStub = nil
+	fake.deleteTagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteTagReturnsOnCall(i int, result1 error) {
+	fake.deleteTagMutex.Lock()
+	defer fake.deleteTagMutex.Unlock()
+	fake.DeleteTagStub = nil
+	if fake.deleteTagReturnsOnCall == nil {
+		fake.deleteTagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteUser(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2, arg3})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeClientInterface) DeleteUserCalls(stub func(context.Context, string, string) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeClientInterface) DeleteUserArgsForCall(i int) (context.Context, string, string) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteVariable(arg1 context.Context, arg2 string) error {
+	fake.deleteVariableMutex.Lock()
+	ret, specificReturn := fake.deleteVariableReturnsOnCall[len(fake.deleteVariableArgsForCall)]
+	fake.deleteVariableArgsForCall = append(fake.deleteVariableArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteVariableStub
+	fakeReturns := fake.deleteVariableReturns
+	fake.recordInvocation("DeleteVariable", []interface{}{arg1, arg2})
+	fake.deleteVariableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) DeleteVariableCallCount() int {
+	fake.deleteVariableMutex.RLock()
+	defer fake.deleteVariableMutex.RUnlock()
+	return len(fake.deleteVariableArgsForCall)
+}
+
+func (fake *FakeClientInterface) DeleteVariableCalls(stub func(context.Context, string) error) {
+	fake.deleteVariableMutex.Lock()
+	defer fake.deleteVariableMutex.Unlock()
+	fake.DeleteVariableStub = stub
+}
+
+func (fake *FakeClientInterface) DeleteVariableArgsForCall(i int) (context.Context, string) {
+	fake.deleteVariableMutex.RLock()
+	defer fake.deleteVariableMutex.RUnlock()
+	argsForCall := fake.deleteVariableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) DeleteVariableReturns(result1 error) {
+	fake.deleteVariableMutex.Lock()
+	defer fake.deleteVariableMutex.Unlock()
+	fake.DeleteVariableStub = nil
+	fake.deleteVariableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteVariableReturnsOnCall(i int, result1 error) {
+	fake.deleteVariableMutex.Lock()
+	defer fake.deleteVariableMutex.Unlock()
+	fake.DeleteVariableStub = nil
+	if fake.deleteVariableReturnsOnCall == nil {
+		fake.deleteVariableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteVariableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) DeleteWorkflow(arg1 context.Context, arg2 string) error {
 	fake.deleteWorkflowMutex.Lock()
 	ret, specificReturn := fake.deleteWorkflowReturnsOnCall[len(fake.deleteWorkflowArgsForCall)]
 	fake.deleteWorkflowArgsForCall = append(fake.deleteWorkflowArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.DeleteWorkflowStub
 	fakeReturns := fake.deleteWorkflowReturns
-	fake.recordInvocation("DeleteWorkflow", []interface{}{arg1})
+	fake.recordInvocation("DeleteWorkflow", []interface{}{arg1, arg2})
 	fake.deleteWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -644,17 +1485,17 @@ func (fake *FakeClientInterface) DeleteWorkflowCallCount() int {
 	return len(fake.deleteWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) DeleteWorkflowCalls(stub func(string) error) {
+func (fake *FakeClientInterface) DeleteWorkflowCalls(stub func(context.Context, string) error) {
 	fake.deleteWorkflowMutex.Lock()
 	defer fake.deleteWorkflowMutex.Unlock()
 	fake.DeleteWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) DeleteWorkflowArgsForCall(i int) string {
+func (fake *FakeClientInterface) DeleteWorkflowArgsForCall(i int) (context.Context, string) {
 	fake.deleteWorkflowMutex.RLock()
 	defer fake.deleteWorkflowMutex.RUnlock()
 	argsForCall := fake.deleteWorkflowArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) DeleteWorkflowReturns(result1 error) {
@@ -680,18 +1521,90 @@ func (fake *FakeClientInterface) DeleteWorkflowReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
-func (fake *FakeClientInterface) GetCredentialSchema(arg1 string) (map[string]interface{}, error) {
+func (fake *FakeClientInterface) GenerateAudit(arg1 context.Context, arg2 []string, arg3 int) (*n8n.Audit, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.generateAuditMutex.Lock()
+	ret, specificReturn := fake.generateAuditReturnsOnCall[len(fake.generateAuditArgsForCall)]
+	fake.generateAuditArgsForCall = append(fake.generateAuditArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 int
+	}{arg1, arg2Copy, arg3})
+	stub := fake.GenerateAuditStub
+	fakeReturns := fake.generateAuditReturns
+	fake.recordInvocation("GenerateAudit", []interface{}{arg1, arg2Copy, arg3})
+	fake.generateAuditMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GenerateAuditCallCount() int {
+	fake.generateAuditMutex.RLock()
+	defer fake.generateAuditMutex.RUnlock()
+	return len(fake.generateAuditArgsForCall)
+}
+
+func (fake *FakeClientInterface) GenerateAuditCalls(stub func(context.Context, []string, int) (*n8n.Audit, error)) {
+	fake.generateAuditMutex.Lock()
+	defer fake.generateAuditMutex.Unlock()
+	fake.GenerateAuditStub = stub
+}
+
+func (fake *FakeClientInterface) GenerateAuditArgsForCall(i int) (context.Context, []string, int) {
+	fake.generateAuditMutex.RLock()
+	defer fake.generateAuditMutex.RUnlock()
+	argsForCall := fake.generateAuditArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) GenerateAuditReturns(result1 *n8n.Audit, result2 error) {
+	fake.generateAuditMutex.Lock()
+	defer fake.generateAuditMutex.Unlock()
+	fake.GenerateAuditStub = nil
+	fake.generateAuditReturns = struct {
+		result1 *n8n.Audit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GenerateAuditReturnsOnCall(i int, result1 *n8n.Audit, result2 error) {
+	fake.generateAuditMutex.Lock()
+	defer fake.generateAuditMutex.Unlock()
+	fake.GenerateAuditStub = nil
+	if fake.generateAuditReturnsOnCall == nil {
+		fake.generateAuditReturnsOnCall = make(map[int]struct {
+			result1 *n8n.Audit
+			result2 error
+		})
+	}
+	fake.generateAuditReturnsOnCall[i] = struct {
+		result1 *n8n.Audit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetCredentialSchema(arg1 context.Context, arg2 string) (map[string]interface{}, error) {
 	fake.getCredentialSchemaMutex.Lock()
 	ret, specificReturn := fake.getCredentialSchemaReturnsOnCall[len(fake.getCredentialSchemaArgsForCall)]
 	fake.getCredentialSchemaArgsForCall = append(fake.getCredentialSchemaArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetCredentialSchemaStub
 	fakeReturns := fake.getCredentialSchemaReturns
-	fake.recordInvocation("GetCredentialSchema", []interface{}{arg1})
+	fake.recordInvocation("GetCredentialSchema", []interface{}{arg1, arg2})
 	fake.getCredentialSchemaMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -705,17 +1618,17 @@ func (fake *FakeClientInterface) GetCredentialSchemaCallCount() int {
 	return len(fake.getCredentialSchemaArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetCredentialSchemaCalls(stub func(string) (map[string]interface{}, error)) {
+func (fake *FakeClientInterface) GetCredentialSchemaCalls(stub func(context.Context, string) (map[string]interface{}, error)) {
 	fake.getCredentialSchemaMutex.Lock()
 	defer fake.getCredentialSchemaMutex.Unlock()
 	fake.GetCredentialSchemaStub = stub
 }
 
-func (fake *FakeClientInterface) GetCredentialSchemaArgsForCall(i int) string {
+func (fake *FakeClientInterface) GetCredentialSchemaArgsForCall(i int) (context.Context, string) {
 	fake.getCredentialSchemaMutex.RLock()
 	defer fake.getCredentialSchemaMutex.RUnlock()
 	argsForCall := fake.getCredentialSchemaArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) GetCredentialSchemaReturns(result1 map[string]interface{}, result2 error) {
@@ -744,19 +1657,20 @@ func (fake *FakeClientInterface) GetCredentialSchemaReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetExecutionById(arg1 string, arg2 bool) (*n8n.Execution, error) {
+func (fake *FakeClientInterface) GetExecutionById(arg1 context.Context, arg2 string, arg3 bool) (*n8n.Execution, error) {
 	fake.getExecutionByIdMutex.Lock()
 	ret, specificReturn := fake.getExecutionByIdReturnsOnCall[len(fake.getExecutionByIdArgsForCall)]
 	fake.getExecutionByIdArgsForCall = append(fake.getExecutionByIdArgsForCall, struct {
-		arg1 string
-		arg2 bool
-	}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
 	stub := fake.GetExecutionByIdStub
 	fakeReturns := fake.getExecutionByIdReturns
-	fake.recordInvocation("GetExecutionById", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetExecutionById", []interface{}{arg1, arg2, arg3})
 	fake.getExecutionByIdMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -770,17 +1684,17 @@ func (fake *FakeClientInterface) GetExecutionByIdCallCount() int {
 	return len(fake.getExecutionByIdArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetExecutionByIdCalls(stub func(string, bool) (*n8n.Execution, error)) {
+func (fake *FakeClientInterface) GetExecutionByIdCalls(stub func(context.Context, string, bool) (*n8n.Execution, error)) {
 	fake.getExecutionByIdMutex.Lock()
 	defer fake.getExecutionByIdMutex.Unlock()
 	fake.GetExecutionByIdStub = stub
 }
 
-func (fake *FakeClientInterface) GetExecutionByIdArgsForCall(i int) (string, bool) {
+func (fake *FakeClientInterface) GetExecutionByIdArgsForCall(i int) (context.Context, string, bool) {
 	fake.getExecutionByIdMutex.RLock()
 	defer fake.getExecutionByIdMutex.RUnlock()
 	argsForCall := fake.getExecutionByIdArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClientInterface) GetExecutionByIdReturns(result1 *n8n.Execution, result2 error) {
@@ -809,22 +1723,23 @@ func (fake *FakeClientInterface) GetExecutionByIdReturnsOnCall(i int, result1 *n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetExecutions(arg1 string, arg2 bool, arg3 string, arg4 int, arg5 string) (*n8n.ExecutionList, error) {
+func (fake *FakeClientInterface) GetExecutions(arg1 context.Context, arg2 string, arg3 bool, arg4 string, arg5 int, arg6 string) (*n8n.ExecutionList, error) {
 	fake.getExecutionsMutex.Lock()
 	ret, specificReturn := fake.getExecutionsReturnsOnCall[len(fake.getExecutionsArgsForCall)]
 	fake.getExecutionsArgsForCall = append(fake.getExecutionsArgsForCall, struct {
-		arg1 string
-		arg2 bool
-		arg3 string
-		arg4 int
-		arg5 string
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+		arg4 string
+		arg5 int
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.GetExecutionsStub
 	fakeReturns := fake.getExecutionsReturns
-	fake.recordInvocation("GetExecutions", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("GetExecutions", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.getExecutionsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -838,17 +1753,17 @@ func (fake *FakeClientInterface) GetExecutionsCallCount() int {
 	return len(fake.getExecutionsArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetExecutionsCalls(stub func(string, bool, string, int, string) (*n8n.ExecutionList, error)) {
+func (fake *FakeClientInterface) GetExecutionsCalls(stub func(context.Context, string, bool, string, int, string) (*n8n.ExecutionList, error)) {
 	fake.getExecutionsMutex.Lock()
 	defer fake.getExecutionsMutex.Unlock()
 	fake.GetExecutionsStub = stub
 }
 
-func (fake *FakeClientInterface) GetExecutionsArgsForCall(i int) (string, bool, string, int, string) {
+func (fake *FakeClientInterface) GetExecutionsArgsForCall(i int) (context.Context, string, bool, string, int, string) {
 	fake.getExecutionsMutex.RLock()
 	defer fake.getExecutionsMutex.RUnlock()
 	argsForCall := fake.getExecutionsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeClientInterface) GetExecutionsReturns(result1 *n8n.ExecutionList, result2 error) {
@@ -877,17 +1792,147 @@ func (fake *FakeClientInterface) GetExecutionsReturnsOnCall(i int, result1 *n8n.
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetTags() (*n8n.TagList, error) {
+func (fake *FakeClientInterface) GetProjectMembers(arg1 context.Context, arg2 string) (*n8n.UserList, error) {
+	fake.getProjectMembersMutex.Lock()
+	ret, specificReturn := fake.getProjectMembersReturnsOnCall[len(fake.getProjectMembersArgsForCall)]
+	fake.getProjectMembersArgsForCall = append(fake.getProjectMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetProjectMembersStub
+	fakeReturns := fake.getProjectMembersReturns
+	fake.recordInvocation("GetProjectMembers", []interface{}{arg1, arg2})
+	fake.getProjectMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetProjectMembersCallCount() int {
+	fake.getProjectMembersMutex.RLock()
+	defer fake.getProjectMembersMutex.RUnlock()
+	return len(fake.getProjectMembersArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetProjectMembersCalls(stub func(context.Context, string) (*n8n.UserList, error)) {
+	fake.getProjectMembersMutex.Lock()
+	defer fake.getProjectMembersMutex.Unlock()
+	fake.GetProjectMembersStub = stub
+}
+
+func (fake *FakeClientInterface) GetProjectMembersArgsForCall(i int) (context.Context, string) {
+	fake.getProjectMembersMutex.RLock()
+	defer fake.getProjectMembersMutex.RUnlock()
+	argsForCall := fake.getProjectMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) GetProjectMembersReturns(result1 *n8n.UserList, result2 error) {
+	fake.getProjectMembersMutex.Lock()
+	defer fake.getProjectMembersMutex.Unlock()
+	fake.GetProjectMembersStub = nil
+	fake.getProjectMembersReturns = struct {
+		result1 *n8n.UserList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetProjectMembersReturnsOnCall(i int, result1 *n8n.UserList, result2 error) {
+	fake.getProjectMembersMutex.Lock()
+	defer fake.getProjectMembersMutex.Unlock()
+	fake.GetProjectMembersStub = nil
+	if fake.getProjectMembersReturnsOnCall == nil {
+		fake.getProjectMembersReturnsOnCall = make(map[int]struct {
+			result1 *n8n.UserList
+			result2 error
+		})
+	}
+	fake.getProjectMembersReturnsOnCall[i] = struct {
+		result1 *n8n.UserList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetProjects(arg1 context.Context) (*n8n.ProjectList, error) {
+	fake.getProjectsMutex.Lock()
+	ret, specificReturn := fake.getProjectsReturnsOnCall[len(fake.getProjectsArgsForCall)]
+	fake.getProjectsArgsForCall = append(fake.getProjectsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetProjectsStub
+	fakeReturns := fake.getProjectsReturns
+	fake.recordInvocation("GetProjects", []interface{}{arg1})
+	fake.getProjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetProjectsCallCount() int {
+	fake.getProjectsMutex.RLock()
+	defer fake.getProjectsMutex.RUnlock()
+	return len(fake.getProjectsArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetProjectsCalls(stub func(context.Context) (*n8n.ProjectList, error)) {
+	fake.getProjectsMutex.Lock()
+	defer fake.getProjectsMutex.Unlock()
+	fake.GetProjectsStub = stub
+}
+
+func (fake *FakeClientInterface) GetProjectsArgsForCall(i int) context.Context {
+	fake.getProjectsMutex.RLock()
+	defer fake.getProjectsMutex.RUnlock()
+	argsForCall := fake.getProjectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientInterface) GetProjectsReturns(result1 *n8n.ProjectList, result2 error) {
+	fake.getProjectsMutex.Lock()
+	defer fake.getProjectsMutex.Unlock()
+	fake.GetProjectsStub = nil
+	fake.getProjectsReturns = struct {
+		result1 *n8n.ProjectList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetProjectsReturnsOnCall(i int, result1 *n8n.ProjectList, result2 error) {
+	fake.getProjectsMutex.Lock()
+	defer fake.getProjectsMutex.Unlock()
+	fake.GetProjectsStub = nil
+	if fake.getProjectsReturnsOnCall == nil {
+		fake.getProjectsReturnsOnCall = make(map[int]struct {
+			result1 *n8n.ProjectList
+			result2 error
+		})
+	}
+	fake.getProjectsReturnsOnCall[i] = struct {
+		result1 *n8n.ProjectList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetTags(arg1 context.Context) (*n8n.TagList, error) {
 	fake.getTagsMutex.Lock()
 	ret, specificReturn := fake.getTagsReturnsOnCall[len(fake.getTagsArgsForCall)]
 	fake.getTagsArgsForCall = append(fake.getTagsArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.GetTagsStub
 	fakeReturns := fake.getTagsReturns
-	fake.recordInvocation("GetTags", []interface{}{})
+	fake.recordInvocation("GetTags", []interface{}{arg1})
 	fake.getTagsMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -901,12 +1946,19 @@ func (fake *FakeClientInterface) GetTagsCallCount() int {
 	return len(fake.getTagsArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetTagsCalls(stub func() (*n8n.TagList, error)) {
+func (fake *FakeClientInterface) GetTagsCalls(stub func(context.Context) (*n8n.TagList, error)) {
 	fake.getTagsMutex.Lock()
 	defer fake.getTagsMutex.Unlock()
 	fake.GetTagsStub = stub
 }
 
+func (fake *FakeClientInterface) GetTagsArgsForCall(i int) context.Context {
+	fake.getTagsMutex.RLock()
+	defer fake.getTagsMutex.RUnlock()
+	argsForCall := fake.getTagsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClientInterface) GetTagsReturns(result1 *n8n.TagList, result2 error) {
 	fake.getTagsMutex.Lock()
 	defer fake.getTagsMutex.Unlock()
@@ -933,18 +1985,147 @@ func (fake *FakeClientInterface) GetTagsReturnsOnCall(i int, result1 *n8n.TagLis
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetWorkflow(arg1 string) (*n8n.Workflow, error) {
+func (fake *FakeClientInterface) GetUsers(arg1 context.Context) (*n8n.UserList, error) {
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetUsersStub
+	fakeReturns := fake.getUsersReturns
+	fake.recordInvocation("GetUsers", []interface{}{arg1})
+	fake.getUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetUsersCalls(stub func(context.Context) (*n8n.UserList, error)) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = stub
+}
+
+func (fake *FakeClientInterface) GetUsersArgsForCall(i int) context.Context {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	argsForCall := fake.getUsersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientInterface) GetUsersReturns(result1 *n8n.UserList, result2 error) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 *n8n.UserList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetUsersReturnsOnCall(i int, result1 *n8n.UserList, result2 error) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 *n8n.UserList
+			result2 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 *n8n.UserList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetVariables(arg1 context.Context) (*n8n.VariableList, error) {
+	fake.getVariablesMutex.Lock()
+	ret, specificReturn := fake.getVariablesReturnsOnCall[len(fake.getVariablesArgsForCall)]
+	fake.getVariablesArgsForCall = append(fake.getVariablesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetVariablesStub
+	fakeReturns := fake.getVariablesReturns
+	fake.recordInvocation("GetVariables", []interface{}{arg1})
+	fake.getVariablesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetVariablesCallCount() int {
+	fake.getVariablesMutex.RLock()
+	defer fake.getVariablesMutex.RUnlock()
+	return len(fake.getVariablesArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetVariablesCalls(stub func(context.Context) (*n8n.VariableList, error)) {
+	fake.getVariablesMutex.Lock()
+	defer fake.getVariablesMutex.Unlock()
+	fake.GetVariablesStub = stub
+}
+
+func (fake *FakeClientInterface) GetVariablesArgsForCall(i int) context.Context {
+	fake.getVariablesMutex.RLock()
+	defer fake.getVariablesMutex.RUnlock()
+	argsForCall := fake.getVariablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientInterface) GetVariablesReturns(result1 *n8n.VariableList, result2 error) {
+	fake.getVariablesMutex.Lock()
+	defer fake.getVariablesMutex.Unlock()
+	fake.GetVariablesStub = nil
+	fake.getVariablesReturns = struct {
+		result1 *n8n.VariableList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetVariablesReturnsOnCall(i int, result1 *n8n.VariableList, result2 error) {
+	fake.getVariablesMutex.Lock()
+	defer fake.getVariablesMutex.Unlock()
+	fake.GetVariablesStub = nil
+	if fake.getVariablesReturnsOnCall == nil {
+		fake.getVariablesReturnsOnCall = make(map[int]struct {
+			result1 *n8n.VariableList
+			result2 error
+		})
+	}
+	fake.getVariablesReturnsOnCall[i] = struct {
+		result1 *n8n.VariableList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetWorkflow(arg1 context.Context, arg2 string) (*n8n.Workflow, error) {
 	fake.getWorkflowMutex.Lock()
 	ret, specificReturn := fake.getWorkflowReturnsOnCall[len(fake.getWorkflowArgsForCall)]
 	fake.getWorkflowArgsForCall = append(fake.getWorkflowArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetWorkflowStub
 	fakeReturns := fake.getWorkflowReturns
-	fake.recordInvocation("GetWorkflow", []interface{}{arg1})
+	fake.recordInvocation("GetWorkflow", []interface{}{arg1, arg2})
 	fake.getWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -958,17 +2139,17 @@ func (fake *FakeClientInterface) GetWorkflowCallCount() int {
 	return len(fake.getWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetWorkflowCalls(stub func(string) (*n8n.Workflow, error)) {
+func (fake *FakeClientInterface) GetWorkflowCalls(stub func(context.Context, string) (*n8n.Workflow, error)) {
 	fake.getWorkflowMutex.Lock()
 	defer fake.getWorkflowMutex.Unlock()
 	fake.GetWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) GetWorkflowArgsForCall(i int) string {
+func (fake *FakeClientInterface) GetWorkflowArgsForCall(i int) (context.Context, string) {
 	fake.getWorkflowMutex.RLock()
 	defer fake.getWorkflowMutex.RUnlock()
 	argsForCall := fake.getWorkflowArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) GetWorkflowReturns(result1 *n8n.Workflow, result2 error) {
@@ -997,18 +2178,19 @@ func (fake *FakeClientInterface) GetWorkflowReturnsOnCall(i int, result1 *n8n.Wo
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetWorkflowTags(arg1 string) (n8n.WorkflowTags, error) {
+func (fake *FakeClientInterface) GetWorkflowTags(arg1 context.Context, arg2 string) (n8n.WorkflowTags, error) {
 	fake.getWorkflowTagsMutex.Lock()
 	ret, specificReturn := fake.getWorkflowTagsReturnsOnCall[len(fake.getWorkflowTagsArgsForCall)]
 	fake.getWorkflowTagsArgsForCall = append(fake.getWorkflowTagsArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetWorkflowTagsStub
 	fakeReturns := fake.getWorkflowTagsReturns
-	fake.recordInvocation("GetWorkflowTags", []interface{}{arg1})
+	fake.recordInvocation("GetWorkflowTags", []interface{}{arg1, arg2})
 	fake.getWorkflowTagsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1022,17 +2204,17 @@ func (fake *FakeClientInterface) GetWorkflowTagsCallCount() int {
 	return len(fake.getWorkflowTagsArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetWorkflowTagsCalls(stub func(string) (n8n.WorkflowTags, error)) {
+func (fake *FakeClientInterface) GetWorkflowTagsCalls(stub func(context.Context, string) (n8n.WorkflowTags, error)) {
 	fake.getWorkflowTagsMutex.Lock()
 	defer fake.getWorkflowTagsMutex.Unlock()
 	fake.GetWorkflowTagsStub = stub
 }
 
-func (fake *FakeClientInterface) GetWorkflowTagsArgsForCall(i int) string {
+func (fake *FakeClientInterface) GetWorkflowTagsArgsForCall(i int) (context.Context, string) {
 	fake.getWorkflowTagsMutex.RLock()
 	defer fake.getWorkflowTagsMutex.RUnlock()
 	argsForCall := fake.getWorkflowTagsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClientInterface) GetWorkflowTagsReturns(result1 n8n.WorkflowTags, result2 error) {
@@ -1061,17 +2243,149 @@ func (fake *FakeClientInterface) GetWorkflowTagsReturnsOnCall(i int, result1 n8n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) GetWorkflows() (*n8n.WorkflowList, error) {
+func (fake *FakeClientInterface) GetWorkflowVersion(arg1 context.Context, arg2 string, arg3 string) (*n8n.WorkflowVersion, error) {
+	fake.getWorkflowVersionMutex.Lock()
+	ret, specificReturn := fake.getWorkflowVersionReturnsOnCall[len(fake.getWorkflowVersionArgsForCall)]
+	fake.getWorkflowVersionArgsForCall = append(fake.getWorkflowVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetWorkflowVersionStub
+	fakeReturns := fake.getWorkflowVersionReturns
+	fake.recordInvocation("GetWorkflowVersion", []interface{}{arg1, arg2, arg3})
+	fake.getWorkflowVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionCallCount() int {
+	fake.getWorkflowVersionMutex.RLock()
+	defer fake.getWorkflowVersionMutex.RUnlock()
+	return len(fake.getWorkflowVersionArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionCalls(stub func(context.Context, string, string) (*n8n.WorkflowVersion, error)) {
+	fake.getWorkflowVersionMutex.Lock()
+	defer fake.getWorkflowVersionMutex.Unlock()
+	fake.GetWorkflowVersionStub = stub
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionArgsForCall(i int) (context.Context, string, string) {
+	fake.getWorkflowVersionMutex.RLock()
+	defer fake.getWorkflowVersionMutex.RUnlock()
+	argsForCall := fake.getWorkflowVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionReturns(result1 *n8n.WorkflowVersion, result2 error) {
+	fake.getWorkflowVersionMutex.Lock()
+	defer fake.getWorkflowVersionMutex.Unlock()
+	fake.GetWorkflowVersionStub = nil
+	fake.getWorkflowVersionReturns = struct {
+		result1 *n8n.WorkflowVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionReturnsOnCall(i int, result1 *n8n.WorkflowVersion, result2 error) {
+	fake.getWorkflowVersionMutex.Lock()
+	defer fake.getWorkflowVersionMutex.Unlock()
+	fake.GetWorkflowVersionStub = nil
+	if fake.getWorkflowVersionReturnsOnCall == nil {
+		fake.getWorkflowVersionReturnsOnCall = make(map[int]struct {
+			result1 *n8n.WorkflowVersion
+			result2 error
+		})
+	}
+	fake.getWorkflowVersionReturnsOnCall[i] = struct {
+		result1 *n8n.WorkflowVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefs(arg1 context.Context, arg2 string) (*n8n.WorkflowVersionRefs, error) {
+	fake.getWorkflowVersionRefsMutex.Lock()
+	ret, specificReturn := fake.getWorkflowVersionRefsReturnsOnCall[len(fake.getWorkflowVersionRefsArgsForCall)]
+	fake.getWorkflowVersionRefsArgsForCall = append(fake.getWorkflowVersionRefsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetWorkflowVersionRefsStub
+	fakeReturns := fake.getWorkflowVersionRefsReturns
+	fake.recordInvocation("GetWorkflowVersionRefs", []interface{}{arg1, arg2})
+	fake.getWorkflowVersionRefsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefsCallCount() int {
+	fake.getWorkflowVersionRefsMutex.RLock()
+	defer fake.getWorkflowVersionRefsMutex.RUnlock()
+	return len(fake.getWorkflowVersionRefsArgsForCall)
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefsCalls(stub func(context.Context, string) (*n8n.WorkflowVersionRefs, error)) {
+	fake.getWorkflowVersionRefsMutex.Lock()
+	defer fake.getWorkflowVersionRefsMutex.Unlock()
+	fake.GetWorkflowVersionRefsStub = stub
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefsArgsForCall(i int) (context.Context, string) {
+	fake.getWorkflowVersionRefsMutex.RLock()
+	defer fake.getWorkflowVersionRefsMutex.RUnlock()
+	argsForCall := fake.getWorkflowVersionRefsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefsReturns(result1 *n8n.WorkflowVersionRefs, result2 error) {
+	fake.getWorkflowVersionRefsMutex.Lock()
+	defer fake.getWorkflowVersionRefsMutex.Unlock()
+	fake.GetWorkflowVersionRefsStub = nil
+	fake.getWorkflowVersionRefsReturns = struct {
+		result1 *n8n.WorkflowVersionRefs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetWorkflowVersionRefsReturnsOnCall(i int, result1 *n8n.WorkflowVersionRefs, result2 error) {
+	fake.getWorkflowVersionRefsMutex.Lock()
+	defer fake.getWorkflowVersionRefsMutex.Unlock()
+	fake.GetWorkflowVersionRefsStub = nil
+	if fake.getWorkflowVersionRefsReturnsOnCall == nil {
+		fake.getWorkflowVersionRefsReturnsOnCall = make(map[int]struct {
+			result1 *n8n.WorkflowVersionRefs
+			result2 error
+		})
+	}
+	fake.getWorkflowVersionRefsReturnsOnCall[i] = struct {
+		result1 *n8n.WorkflowVersionRefs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) GetWorkflows(arg1 context.Context) (*n8n.WorkflowList, error) {
 	fake.getWorkflowsMutex.Lock()
 	ret, specificReturn := fake.getWorkflowsReturnsOnCall[len(fake.getWorkflowsArgsForCall)]
 	fake.getWorkflowsArgsForCall = append(fake.getWorkflowsArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.GetWorkflowsStub
 	fakeReturns := fake.getWorkflowsReturns
-	fake.recordInvocation("GetWorkflows", []interface{}{})
+	fake.recordInvocation("GetWorkflows", []interface{}{arg1})
 	fake.getWorkflowsMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1085,12 +2399,19 @@ func (fake *FakeClientInterface) GetWorkflowsCallCount() int {
 	return len(fake.getWorkflowsArgsForCall)
 }
 
-func (fake *FakeClientInterface) GetWorkflowsCalls(stub func() (*n8n.WorkflowList, error)) {
+func (fake *FakeClientInterface) GetWorkflowsCalls(stub func(context.Context) (*n8n.WorkflowList, error)) {
 	fake.getWorkflowsMutex.Lock()
 	defer fake.getWorkflowsMutex.Unlock()
 	fake.GetWorkflowsStub = stub
 }
 
+func (fake *FakeClientInterface) GetWorkflowsArgsForCall(i int) context.Context {
+	fake.getWorkflowsMutex.RLock()
+	defer fake.getWorkflowsMutex.RUnlock()
+	argsForCall := fake.getWorkflowsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClientInterface) GetWorkflowsReturns(result1 *n8n.WorkflowList, result2 error) {
 	fake.getWorkflowsMutex.Lock()
 	defer fake.getWorkflowsMutex.Unlock()
@@ -1117,19 +2438,219 @@ func (fake *FakeClientInterface) GetWorkflowsReturnsOnCall(i int, result1 *n8n.W
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) TransferCredential(arg1 string, arg2 string) error {
+func (fake *FakeClientInterface) InviteUsers(arg1 context.Context, arg2 []n8n.UserInvite) ([]n8n.UserInvitation, error) {
+	var arg2Copy []n8n.UserInvite
+	if arg2 != nil {
+		arg2Copy = make([]n8n.UserInvite, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.inviteUsersMutex.Lock()
+	ret, specificReturn := fake.inviteUsersReturnsOnCall[len(fake.inviteUsersArgsForCall)]
+	fake.inviteUsersArgsForCall = append(fake.inviteUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 []n8n.UserInvite
+	}{arg1, arg2Copy})
+	stub := fake.InviteUsersStub
+	fakeReturns := fake.inviteUsersReturns
+	fake.recordInvocation("InviteUsers", []interface{}{arg1, arg2Copy})
+	fake.inviteUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) InviteUsersCallCount() int {
+	fake.inviteUsersMutex.RLock()
+	defer fake.inviteUsersMutex.RUnlock()
+	return len(fake.inviteUsersArgsForCall)
+}
+
+func (fake *FakeClientInterface) InviteUsersCalls(stub func(context.Context, []n8n.UserInvite) ([]n8n.UserInvitation, error)) {
+	fake.inviteUsersMutex.Lock()
+	defer fake.inviteUsersMutex.Unlock()
+	fake.InviteUsersStub = stub
+}
+
+func (fake *FakeClientInterface) InviteUsersArgsForCall(i int) (context.Context, []n8n.UserInvite) {
+	fake.inviteUsersMutex.RLock()
+	defer fake.inviteUsersMutex.RUnlock()
+	argsForCall := fake.inviteUsersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientInterface) InviteUsersReturns(result1 []n8n.UserInvitation, result2 error) {
+	fake.inviteUsersMutex.Lock()
+	defer fake.inviteUsersMutex.Unlock()
+	fake.InviteUsersStub = nil
+	fake.inviteUsersReturns = struct {
+		result1 []n8n.UserInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) InviteUsersReturnsOnCall(i int, result1 []n8n.UserInvitation, result2 error) {
+	fake.inviteUsersMutex.Lock()
+	defer fake.inviteUsersMutex.Unlock()
+	fake.InviteUsersStub = nil
+	if fake.inviteUsersReturnsOnCall == nil {
+		fake.inviteUsersReturnsOnCall = make(map[int]struct {
+			result1 []n8n.UserInvitation
+			result2 error
+		})
+	}
+	fake.inviteUsersReturnsOnCall[i] = struct {
+		result1 []n8n.UserInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) RemoveProjectMember(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeProjectMemberMutex.Lock()
+	ret, specificReturn := fake.removeProjectMemberReturnsOnCall[len(fake.removeProjectMemberArgsForCall)]
+	fake.removeProjectMemberArgsForCall = append(fake.removeProjectMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveProjectMemberStub
+	fakeReturns := fake.removeProjectMemberReturns
+	fake.recordInvocation("RemoveProjectMember", []interface{}{arg1, arg2, arg3})
+	fake.removeProjectMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) RemoveProjectMemberCallCount() int {
+	fake.removeProjectMemberMutex.RLock()
+	defer fake.removeProjectMemberMutex.RUnlock()
+	return len(fake.removeProjectMemberArgsForCall)
+}
+
+func (fake *FakeClientInterface) RemoveProjectMemberCalls(stub func(context.Context, string, string) error) {
+	fake.removeProjectMemberMutex.Lock()
+	defer fake.removeProjectMemberMutex.Unlock()
+	fake.RemoveProjectMemberStub = stub
+}
+
+func (fake *FakeClientInterface) RemoveProjectMemberArgsForCall(i int) (context.Context, string, string) {
+	fake.removeProjectMemberMutex.RLock()
+	defer fake.removeProjectMemberMutex.RUnlock()
+	argsForCall := fake.removeProjectMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) RemoveProjectMemberReturns(result1 error) {
+	fake.removeProjectMemberMutex.Lock()
+	defer fake.removeProjectMemberMutex.Unlock()
+	fake.RemoveProjectMemberStub = nil
+	fake.removeProjectMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) RemoveProjectMemberReturnsOnCall(i int, result1 error) {
+	fake.removeProjectMemberMutex.Lock()
+	defer fake.removeProjectMemberMutex.Unlock()
+	fake.RemoveProjectMemberStub = nil
+	if fake.removeProjectMemberReturnsOnCall == nil {
+		fake.removeProjectMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeProjectMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) RetryExecution(arg1 context.Context, arg2 string, arg3 bool) (*n8n.Execution, error) {
+	fake.retryExecutionMutex.Lock()
+	ret, specificReturn := fake.retryExecutionReturnsOnCall[len(fake.retryExecutionArgsForCall)]
+	fake.retryExecutionArgsForCall = append(fake.retryExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.RetryExecutionStub
+	fakeReturns := fake.retryExecutionReturns
+	fake.recordInvocation("RetryExecution", []interface{}{arg1, arg2, arg3})
+	fake.retryExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) RetryExecutionCallCount() int {
+	fake.retryExecutionMutex.RLock()
+	defer fake.retryExecutionMutex.RUnlock()
+	return len(fake.retryExecutionArgsForCall)
+}
+
+func (fake *FakeClientInterface) RetryExecutionCalls(stub func(context.Context, string, bool) (*n8n.Execution, error)) {
+	fake.retryExecutionMutex.Lock()
+	defer fake.retryExecutionMutex.Unlock()
+	fake.RetryExecutionStub = stub
+}
+
+func (fake *FakeClientInterface) RetryExecutionArgsForCall(i int) (context.Context, string, bool) {
+	fake.retryExecutionMutex.RLock()
+	defer fake.retryExecutionMutex.RUnlock()
+	argsForCall := fake.retryExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) RetryExecutionReturns(result1 *n8n.Execution, result2 error) {
+	fake.retryExecutionMutex.Lock()
+	defer fake.retryExecutionMutex.Unlock()
+	fake.RetryExecutionStub = nil
+	fake.retryExecutionReturns = struct {
+		result1 *n8n.Execution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) RetryExecutionReturnsOnCall(i int, result1 *n8n.Execution, result2 error) {
+	fake.retryExecutionMutex.Lock()
+	defer fake.retryExecutionMutex.Unlock()
+	fake.RetryExecutionStub = nil
+	if fake.retryExecutionReturnsOnCall == nil {
+		fake.retryExecutionReturnsOnCall = make(map[int]struct {
+			result1 *n8n.Execution
+			result2 error
+		})
+	}
+	fake.retryExecutionReturnsOnCall[i] = struct {
+		result1 *n8n.Execution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) TransferCredential(arg1 context.Context, arg2 string, arg3 string) error {
 	fake.transferCredentialMutex.Lock()
 	ret, specificReturn := fake.transferCredentialReturnsOnCall[len(fake.transferCredentialArgsForCall)]
 	fake.transferCredentialArgsForCall = append(fake.transferCredentialArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.TransferCredentialStub
 	fakeReturns := fake.transferCredentialReturns
-	fake.recordInvocation("TransferCredential", []interface{}{arg1, arg2})
+	fake.recordInvocation("TransferCredential", []interface{}{arg1, arg2, arg3})
 	fake.transferCredentialMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1143,17 +2664,17 @@ func (fake *FakeClientInterface) TransferCredentialCallCount() int {
 	return len(fake.transferCredentialArgsForCall)
 }
 
-func (fake *FakeClientInterface) TransferCredentialCalls(stub func(string, string) error) {
+func (fake *FakeClientInterface) TransferCredentialCalls(stub func(context.Context, string, string) error) {
 	fake.transferCredentialMutex.Lock()
 	defer fake.transferCredentialMutex.Unlock()
 	fake.TransferCredentialStub = stub
 }
 
-func (fake *FakeClientInterface) TransferCredentialArgsForCall(i int) (string, string) {
+func (fake *FakeClientInterface) TransferCredentialArgsForCall(i int) (context.Context, string, string) {
 	fake.transferCredentialMutex.RLock()
 	defer fake.transferCredentialMutex.RUnlock()
 	argsForCall := fake.transferCredentialArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClientInterface) TransferCredentialReturns(result1 error) {
@@ -1179,19 +2700,341 @@ func (fake *FakeClientInterface) TransferCredentialReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeClientInterface) UpdateWorkflow(arg1 string, arg2 *n8n.Workflow) (*n8n.Workflow, error) {
+func (fake *FakeClientInterface) TransferWorkflow(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.transferWorkflowMutex.Lock()
+	ret, specificReturn := fake.transferWorkflowReturnsOnCall[len(fake.transferWorkflowArgsForCall)]
+	fake.transferWorkflowArgsForCall = append(fake.transferWorkflowArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TransferWorkflowStub
+	fakeReturns := fake.transferWorkflowReturns
+	fake.recordInvocation("TransferWorkflow", []interface{}{arg1, arg2, arg3})
+	fake.transferWorkflowMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) TransferWorkflowCallCount() int {
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	return len(fake.transferWorkflowArgsForCall)
+}
+
+func (fake *FakeClientInterface) TransferWorkflowCalls(stub func(context.Context, string, string) error) {
+	fake.transferWorkflowMutex.Lock()
+	defer fake.transferWorkflowMutex.Unlock()
+	fake.TransferWorkflowStub = stub
+}
+
+func (fake *FakeClientInterface) TransferWorkflowArgsForCall(i int) (context.Context, string, string) {
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	argsForCall := fake.transferWorkflowArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) TransferWorkflowReturns(result1 error) {
+	fake.transferWorkflowMutex.Lock()
+	defer fake.transferWorkflowMutex.Unlock()
+	fake.TransferWorkflowStub = nil
+	fake.transferWorkflowReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) TransferWorkflowReturnsOnCall(i int, result1 error) {
+	fake.transferWorkflowMutex.Lock()
+	defer fake.transferWorkflowMutex.Unlock()
+	fake.TransferWorkflowStub = nil
+	if fake.transferWorkflowReturnsOnCall == nil {
+		fake.transferWorkflowReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.transferWorkflowReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) UpdateCredential(arg1 context.Context, arg2 string, arg3 *n8n.CredentialUpdate) (*n8n.CreateCredentialResponse, error) {
+	fake.updateCredentialMutex.Lock()
+	ret, specificReturn := fake.updateCredentialReturnsOnCall[len(fake.updateCredentialArgsForCall)]
+	fake.updateCredentialArgsForCall = append(fake.updateCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.CredentialUpdate
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCredentialStub
+	fakeReturns := fake.updateCredentialReturns
+	fake.recordInvocation("UpdateCredential", []interface{}{arg1, arg2, arg3})
+	fake.updateCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) UpdateCredentialCallCount() int {
+	fake.updateCredentialMutex.RLock()
+	defer fake.updateCredentialMutex.RUnlock()
+	return len(fake.updateCredentialArgsForCall)
+}
+
+func (fake *FakeClientInterface) UpdateCredentialCalls(stub func(context.Context, string, *n8n.CredentialUpdate) (*n8n.CreateCredentialResponse, error)) {
+	fake.updateCredentialMutex.Lock()
+	defer fake.updateCredentialMutex.Unlock()
+	fake.UpdateCredentialStub = stub
+}
+
+func (fake *FakeClientInterface) UpdateCredentialArgsForCall(i int) (context.Context, string, *n8n.CredentialUpdate) {
+	fake.updateCredentialMutex.RLock()
+	defer fake.updateCredentialMutex.RUnlock()
+	argsForCall := fake.updateCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) UpdateCredentialReturns(result1 *n8n.CreateCredentialResponse, result2 error) {
+	fake.updateCredentialMutex.Lock()
+	defer fake.updateCredentialMutex.Unlock()
+	fake.UpdateCredentialStub = nil
+	fake.updateCredentialReturns = struct {
+		result1 *n8n.CreateCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) UpdateCredentialReturnsOnCall(i int, result1 *n8n.CreateCredentialResponse, result2 error) {
+	fake.updateCredentialMutex.Lock()
+	defer fake.updateCredentialMutex.Unlock()
+	fake.UpdateCredentialStub = nil
+	if fake.updateCredentialReturnsOnCall == nil {
+		fake.updateCredentialReturnsOnCall = make(map[int]struct {
+			result1 *n8n.CreateCredentialResponse
+			result2 error
+		})
+	}
+	fake.updateCredentialReturnsOnCall[i] = struct {
+		result1 *n8n.CreateCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) UpdateProject(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateProjectMutex.Lock()
+	ret, specificReturn := fake.updateProjectReturnsOnCall[len(fake.updateProjectArgsForCall)]
+	fake.updateProjectArgsForCall = append(fake.updateProjectArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateProjectStub
+	fakeReturns := fake.updateProjectReturns
+	fake.recordInvocation("UpdateProject", []interface{}{arg1, arg2, arg3})
+	fake.updateProjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) UpdateProjectCallCount() int {
+	fake.updateProjectMutex.RLock()
+	defer fake.updateProjectMutex.RUnlock()
+	return len(fake.updateProjectArgsForCall)
+}
+
+func (fake *FakeClientInterface) UpdateProjectCalls(stub func(context.Context, string, string) error) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = stub
+}
+
+func (fake *FakeClientInterface) UpdateProjectArgsForCall(i int) (context.Context, string, string) {
+	fake.updateProjectMutex.RLock()
+	defer fake.updateProjectMutex.RUnlock()
+	argsForCall := fake.updateProjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) UpdateProjectReturns(result1 error) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = nil
+	fake.updateProjectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) UpdateProjectReturnsOnCall(i int, result1 error) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = nil
+	if fake.updateProjectReturnsOnCall == nil {
+		fake.updateProjectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateProjectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) UpdateTag(arg1 context.Context, arg2 string, arg3 string) (*n8n.Tag, error) {
+	fake.updateTagMutex.Lock()
+	ret, specificReturn := fake.updateTagReturnsOnCall[len(fake.updateTagArgsForCall)]
+	fake.updateTagArgsForCall = append(fake.updateTagArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTagStub
+	fakeReturns := fake.updateTagReturns
+	fake.recordInvocation("UpdateTag", []interface{}{arg1, arg2, arg3})
+	fake.updateTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientInterface) UpdateTagCallCount() int {
+	fake.updateTagMutex.RLock()
+	defer fake.updateTagMutex.RUnlock()
+	return len(fake.updateTagArgsForCall)
+}
+
+func (fake *FakeClientInterface) UpdateTagCalls(stub func(context.Context, string, string) (*n8n.Tag, error)) {
+	fake.updateTagMutex.Lock()
+	defer fake.updateTagMutex.Unlock()
+	fake.UpdateTagStub = stub
+}
+
+func (fake *FakeClientInterface) UpdateTagArgsForCall(i int) (context.Context, string, string) {
+	fake.updateTagMutex.RLock()
+	defer fake.updateTagMutex.RUnlock()
+	argsForCall := fake.updateTagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) UpdateTagReturns(result1 *n8n.Tag, result2 error) {
+	fake.updateTagMutex.Lock()
+	defer fake.updateTagMutex.Unlock()
+	fake.UpdateTagStub = nil
+	fake.updateTagReturns = struct {
+		result1 *n8n.Tag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) UpdateTagReturnsOnCall(i int, result1 *n8n.Tag, result2 error) {
+	fake.updateTagMutex.Lock()
+	defer fake.updateTagMutex.Unlock()
+	fake.UpdateTagStub = nil
+	if fake.updateTagReturnsOnCall == nil {
+		fake.updateTagReturnsOnCall = make(map[int]struct {
+			result1 *n8n.Tag
+			result2 error
+		})
+	}
+	fake.updateTagReturnsOnCall[i] = struct {
+		result1 *n8n.Tag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientInterface) UpdateVariable(arg1 context.Context, arg2 string, arg3 *n8n.Variable) error {
+	fake.updateVariableMutex.Lock()
+	ret, specificReturn := fake.updateVariableReturnsOnCall[len(fake.updateVariableArgsForCall)]
+	fake.updateVariableArgsForCall = append(fake.updateVariableArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.Variable
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateVariableStub
+	fakeReturns := fake.updateVariableReturns
+	fake.recordInvocation("UpdateVariable", []interface{}{arg1, arg2, arg3})
+	fake.updateVariableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientInterface) UpdateVariableCallCount() int {
+	fake.updateVariableMutex.RLock()
+	defer fake.updateVariableMutex.RUnlock()
+	return len(fake.updateVariableArgsForCall)
+}
+
+func (fake *FakeClientInterface) UpdateVariableCalls(stub func(context.Context, string, *n8n.Variable) error) {
+	fake.updateVariableMutex.Lock()
+	defer fake.updateVariableMutex.Unlock()
+	fake.UpdateVariableStub = stub
+}
+
+func (fake *FakeClientInterface) UpdateVariableArgsForCall(i int) (context.Context, string, *n8n.Variable) {
+	fake.updateVariableMutex.RLock()
+	defer fake.updateVariableMutex.RUnlock()
+	argsForCall := fake.updateVariableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientInterface) UpdateVariableReturns(result1 error) {
+	fake.updateVariableMutex.Lock()
+	defer fake.updateVariableMutex.Unlock()
+	fake.UpdateVariableStub = nil
+	fake.updateVariableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) UpdateVariableReturnsOnCall(i int, result1 error) {
+	fake.updateVariableMutex.Lock()
+	defer fake.updateVariableMutex.Unlock()
+	fake.UpdateVariableStub = nil
+	if fake.updateVariableReturnsOnCall == nil {
+		fake.updateVariableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateVariableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientInterface) UpdateWorkflow(arg1 context.Context, arg2 string, arg3 *n8n.Workflow) (*n8n.Workflow, error) {
 	fake.updateWorkflowMutex.Lock()
 	ret, specificReturn := fake.updateWorkflowReturnsOnCall[len(fake.updateWorkflowArgsForCall)]
 	fake.updateWorkflowArgsForCall = append(fake.updateWorkflowArgsForCall, struct {
-		arg1 string
-		arg2 *n8n.Workflow
-	}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *n8n.Workflow
+	}{arg1, arg2, arg3})
 	stub := fake.UpdateWorkflowStub
 	fakeReturns := fake.updateWorkflowReturns
-	fake.recordInvocation("UpdateWorkflow", []interface{}{arg1, arg2})
+	fake.recordInvocation("UpdateWorkflow", []interface{}{arg1, arg2, arg3})
 	fake.updateWorkflowMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1205,17 +3048,17 @@ func (fake *FakeClientInterface) UpdateWorkflowCallCount() int {
 	return len(fake.updateWorkflowArgsForCall)
 }
 
-func (fake *FakeClientInterface) UpdateWorkflowCalls(stub func(string, *n8n.Workflow) (*n8n.Workflow, error)) {
+func (fake *FakeClientInterface) UpdateWorkflowCalls(stub func(context.Context, string, *n8n.Workflow) (*n8n.Workflow, error)) {
 	fake.updateWorkflowMutex.Lock()
 	defer fake.updateWorkflowMutex.Unlock()
 	fake.UpdateWorkflowStub = stub
 }
 
-func (fake *FakeClientInterface) UpdateWorkflowArgsForCall(i int) (string, *n8n.Workflow) {
+func (fake *FakeClientInterface) UpdateWorkflowArgsForCall(i int) (context.Context, string, *n8n.Workflow) {
 	fake.updateWorkflowMutex.RLock()
 	defer fake.updateWorkflowMutex.RUnlock()
 	argsForCall := fake.updateWorkflowArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClientInterface) UpdateWorkflowReturns(result1 *n8n.Workflow, result2 error) {
@@ -1244,19 +3087,20 @@ func (fake *FakeClientInterface) UpdateWorkflowReturnsOnCall(i int, result1 *n8n
 	}{result1, result2}
 }
 
-func (fake *FakeClientInterface) UpdateWorkflowTags(arg1 string, arg2 n8n.TagIds) (n8n.WorkflowTags, error) {
+func (fake *FakeClientInterface) UpdateWorkflowTags(arg1 context.Context, arg2 string, arg3 n8n.TagIds) (n8n.WorkflowTags, error) {
 	fake.updateWorkflowTagsMutex.Lock()
 	ret, specificReturn := fake.updateWorkflowTagsReturnsOnCall[len(fake.updateWorkflowTagsArgsForCall)]
 	fake.updateWorkflowTagsArgsForCall = append(fake.updateWorkflowTagsArgsForCall, struct {
-		arg1 string
-		arg2 n8n.TagIds
-	}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 n8n.TagIds
+	}{arg1, arg2, arg3})
 	stub := fake.UpdateWorkflowTagsStub
 	fakeReturns := fake.updateWorkflowTagsReturns
-	fake.recordInvocation("UpdateWorkflowTags", []interface{}{arg1, arg2})
+	fake.recordInvocation("UpdateWorkflowTags", []interface{}{arg1, arg2, arg3})
 	fake.updateWorkflowTagsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1270,17 +3114,17 @@ func (fake *FakeClientInterface) UpdateWorkflowTagsCallCount() int {
 	return len(fake.updateWorkflowTagsArgsForCall)
 }
 
-func (fake *FakeClientInterface) UpdateWorkflowTagsCalls(stub func(string, n8n.TagIds) (n8n.WorkflowTags, error)) {
+func (fake *FakeClientInterface) UpdateWorkflowTagsCalls(stub func(context.Context, string, n8n.TagIds) (n8n.WorkflowTags, error)) {
 	fake.updateWorkflowTagsMutex.Lock()
 	defer fake.updateWorkflowTagsMutex.Unlock()
 	fake.UpdateWorkflowTagsStub = stub
 }
 
-func (fake *FakeClientInterface) UpdateWorkflowTagsArgsForCall(i int) (string, n8n.TagIds) {
+func (fake *FakeClientInterface) UpdateWorkflowTagsArgsForCall(i int) (context.Context, string, n8n.TagIds) {
 	fake.updateWorkflowTagsMutex.RLock()
 	defer fake.updateWorkflowTagsMutex.RUnlock()
 	argsForCall := fake.updateWorkflowTagsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClientInterface) UpdateWorkflowTagsReturns(result1 n8n.WorkflowTags, result2 error) {
@@ -1314,34 +3158,80 @@ func (fake *FakeClientInterface) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.activateWorkflowMutex.RLock()
 	defer fake.activateWorkflowMutex.RUnlock()
+	fake.addProjectMembersMutex.RLock()
+	defer fake.addProjectMembersMutex.RUnlock()
+	fake.changeUserRoleMutex.RLock()
+	defer fake.changeUserRoleMutex.RUnlock()
 	fake.createCredentialMutex.RLock()
 	defer fake.createCredentialMutex.RUnlock()
+	fake.createProjectMutex.RLock()
+	defer fake.createProjectMutex.RUnlock()
 	fake.createTagMutex.RLock()
 	defer fake.createTagMutex.RUnlock()
+	fake.createVariableMutex.RLock()
+	defer fake.createVariableMutex.RUnlock()
 	fake.createWorkflowMutex.RLock()
 	defer fake.createWorkflowMutex.RUnlock()
 	fake.deactivateWorkflowMutex.RLock()
 	defer fake.deactivateWorkflowMutex.RUnlock()
 	fake.deleteCredentialMutex.RLock()
 	defer fake.deleteCredentialMutex.RUnlock()
+	fake.deleteProjectMutex.RLock()
+	defer fake.deleteProjectMutex.RUnlock()
+	fake.deleteTagMutex.RLock()
+	defer fake.deleteTagMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.deleteVariableMutex.RLock()
+	defer fake.deleteVariableMutex.RUnlock()
 	fake.deleteWorkflowMutex.RLock()
 	defer fake.deleteWorkflowMutex.RUnlock()
+	fake.generateAuditMutex.RLock()
+	defer fake.generateAuditMutex.RUnlock()
 	fake.getCredentialSchemaMutex.RLock()
 	defer fake.getCredentialSchemaMutex.RUnlock()
 	fake.getExecutionByIdMutex.RLock()
 	defer fake.getExecutionByIdMutex.RUnlock()
 	fake.getExecutionsMutex.RLock()
 	defer fake.getExecutionsMutex.RUnlock()
+	fake.getProjectMembersMutex.RLock()
+	defer fake.getProjectMembersMutex.RUnlock()
+	fake.getProjectsMutex.RLock()
+	defer fake.getProjectsMutex.RUnlock()
 	fake.getTagsMutex.RLock()
 	defer fake.getTagsMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	fake.getVariablesMutex.RLock()
+	defer fake.getVariablesMutex.RUnlock()
 	fake.getWorkflowMutex.RLock()
 	defer fake.getWorkflowMutex.RUnlock()
 	fake.getWorkflowTagsMutex.RLock()
 	defer fake.getWorkflowTagsMutex.RUnlock()
+	fake.getWorkflowVersionMutex.RLock()
+	defer fake.getWorkflowVersionMutex.RUnlock()
+	fake.getWorkflowVersionRefsMutex.RLock()
+	defer fake.getWorkflowVersionRefsMutex.RUnlock()
 	fake.getWorkflowsMutex.RLock()
 	defer fake.getWorkflowsMutex.RUnlock()
+	fake.inviteUsersMutex.RLock()
+	defer fake.inviteUsersMutex.RUnlock()
+	fake.removeProjectMemberMutex.RLock()
+	defer fake.removeProjectMemberMutex.RUnlock()
+	fake.retryExecutionMutex.RLock()
+	defer fake.retryExecutionMutex.RUnlock()
 	fake.transferCredentialMutex.RLock()
 	defer fake.transferCredentialMutex.RUnlock()
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	fake.updateCredentialMutex.RLock()
+	defer fake.updateCredentialMutex.RUnlock()
+	fake.updateProjectMutex.RLock()
+	defer fake.updateProjectMutex.RUnlock()
+	fake.updateTagMutex.RLock()
+	defer fake.updateTagMutex.RUnlock()
+	fake.updateVariableMutex.RLock()
+	defer fake.updateVariableMutex.RUnlock()
 	fake.updateWorkflowMutex.RLock()
 	defer fake.updateWorkflowMutex.RUnlock()
 	fake.updateWorkflowTagsMutex.RLock()
@@ -0,0 +1,250 @@
+package n8n
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// SchemaViolation is a value of credential data that does not match the schema
+// of its credential type, Path is the dotted path of the field
+type SchemaViolation struct {
+	Path    string
+	Message string
+}
+
+func (v SchemaViolation) Error() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// SchemaProperty is a field of a credential type as described by its schema
+type SchemaProperty struct {
+	Name     string
+	Type     string
+	Enum     []interface{}
+	Required bool
+}
+
+// ValidateCredentialData checks credential data against the JSON Schema returned by
+// GetCredentialSchema. It covers the subset of JSON Schema n8n generates for
+// credential types: type, properties, required, enum, const, additionalProperties,
+// items, allOf and if/then/else. Violations are sorted by path and only describe
+// the type of an invalid value, never the value.
+func ValidateCredentialData(schema map[string]interface{}, data map[string]interface{}) []SchemaViolation {
+	violations := validateSchemaValue(schema, data, "")
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations
+}
+
+// CredentialSchemaProperties returns the top level fields of a credential schema,
+// the required fields first and then by name
+func CredentialSchemaProperties(schema map[string]interface{}) []SchemaProperty {
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := schemaRequired(schema)
+
+	result := make([]SchemaProperty, 0, len(properties))
+	for name, raw := range properties {
+		property := SchemaProperty{Name: name, Required: required[name]}
+		if definition, ok := raw.(map[string]interface{}); ok {
+			property.Type, _ = definition["type"].(string)
+			property.Enum, _ = definition["enum"].([]interface{})
+		}
+		result = append(result, property)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Required != result[j].Required {
+			return result[i].Required
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func validateSchemaValue(schema map[string]interface{}, value interface{}, path string) []SchemaViolation {
+	var violations []SchemaViolation
+
+	if expected, ok := schema["type"].(string); ok && !matchesSchemaType(expected, value) {
+		// The other keywords would only repeat the same problem
+		return []SchemaViolation{{Path: path, Message: fmt.Sprintf("must be of type %s, got %s", expected, jsonTypeName(value))}}
+	}
+
+	// The value itself is never part of a message, it may be a resolved secret
+	if options, ok := schema["enum"].([]interface{}); ok && !containsSchemaValue(options, value) {
+		violations = append(violations, SchemaViolation{Path: path, Message: fmt.Sprintf("must be one of %s, got a value of type %s", formatSchemaValues(options), jsonTypeName(value))})
+	}
+	if constant, ok := schema["const"]; ok && !containsSchemaValue([]interface{}{constant}, value) {
+		violations = append(violations, SchemaViolation{Path: path, Message: fmt.Sprintf("must be %s, got a value of type %s", formatSchemaValue(constant), jsonTypeName(value))})
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		violations = append(violations, validateSchemaObject(schema, object, path)...)
+	}
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		if array, ok := value.([]interface{}); ok {
+			for i, item := range array {
+				violations = append(violations, validateSchemaValue(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, raw := range allOf {
+			if subschema, ok := raw.(map[string]interface{}); ok {
+				violations = append(violations, validateSchemaValue(subschema, value, path)...)
+			}
+		}
+	}
+
+	if condition, ok := schema["if"].(map[string]interface{}); ok {
+		branch := "else"
+		if len(validateSchemaValue(condition, value, path)) == 0 {
+			branch = "then"
+		}
+		if subschema, ok := schema[branch].(map[string]interface{}); ok {
+			violations = append(violations, validateSchemaValue(subschema, value, path)...)
+		}
+	}
+
+	return violations
+}
+
+func validateSchemaObject(schema map[string]interface{}, object map[string]interface{}, path string) []SchemaViolation {
+	var violations []SchemaViolation
+
+	required := schemaRequired(schema)
+	names := make([]string, 0, len(required))
+	for name := range required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := object[name]; !ok {
+			violations = append(violations, SchemaViolation{Path: joinSchemaPath(path, name), Message: "is required"})
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	additional, restricted := schema["additionalProperties"].(bool)
+	for name, value := range object {
+		definition, known := properties[name].(map[string]interface{})
+		if !known {
+			if _, declared := properties[name]; !declared && restricted && !additional {
+				violations = append(violations, SchemaViolation{Path: joinSchemaPath(path, name), Message: unknownFieldMessage(name, properties)})
+			}
+			continue
+		}
+		violations = append(violations, validateSchemaValue(definition, value, joinSchemaPath(path, name))...)
+	}
+
+	return violations
+}
+
+func schemaRequired(schema map[string]interface{}) map[string]bool {
+	required := make(map[string]bool)
+	list, _ := schema["required"].([]interface{})
+	for _, raw := range list {
+		if name, ok := raw.(string); ok {
+			required[name] = true
+		}
+	}
+	return required
+}
+
+// unknownFieldMessage suggests a known field that only differs in case, the most
+// common typo in hand written credential data
+func unknownFieldMessage(name string, properties map[string]interface{}) string {
+	for known := range properties {
+		if strings.EqualFold(known, name) {
+			return fmt.Sprintf("is not a field of this credential type, did you mean %q?", known)
+		}
+	}
+	return "is not a field of this credential type"
+}
+
+func matchesSchemaType(expected string, value interface{}) bool {
+	switch expected {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "null":
+		return value == nil
+	default:
+		// Types outside of JSON Schema are left to n8n
+		return true
+	}
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func containsSchemaValue(options []interface{}, value interface{}) bool {
+	for _, option := range options {
+		if formatSchemaValue(option) == formatSchemaValue(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatSchemaValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatSchemaValue(value)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatSchemaValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -44,6 +44,7 @@ type ExecutionWithFlexibleIDs struct {
 	RetryOf        *FlexibleID             `json:"retryOf,omitempty"`
 	RetrySuccessId *FlexibleID             `json:"retrySuccessId,omitempty"`
 	StartedAt      *time.Time              `json:"startedAt,omitempty"`
+	Status         *ExecutionStatus        `json:"status,omitempty"`
 	StoppedAt      *time.Time              `json:"stoppedAt,omitempty"`
 	WaitTill       *time.Time              `json:"waitTill,omitempty"`
 	WorkflowId     *FlexibleID             `json:"workflowId,omitempty"`
@@ -83,6 +84,7 @@ func toExecution(e ExecutionWithFlexibleIDs) Execution {
 		Finished:   e.Finished,
 		Mode:       e.Mode,
 		StartedAt:  e.StartedAt,
+		Status:     e.Status,
 		StoppedAt:  e.StoppedAt,
 		WaitTill:   e.WaitTill,
 	}
@@ -122,3 +124,58 @@ func toExecution(e ExecutionWithFlexibleIDs) Execution {
 
 	return result
 }
+
+// ProjectRelation assigns a project role, such as project:editor, to a user
+type ProjectRelation struct {
+	UserId string `json:"userId"`
+	Role   string `json:"role"`
+}
+
+// UserInvite is a user to invite to the instance with an optional global role, such as global:member
+type UserInvite struct {
+	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
+}
+
+// UserInvitation is the result of inviting one user
+type UserInvitation struct {
+	User *struct {
+		Id              string `json:"id"`
+		Email           string `json:"email"`
+		InviteAcceptUrl string `json:"inviteAcceptUrl,omitempty"`
+		EmailSent       bool   `json:"emailSent"`
+	} `json:"user,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// WorkflowVersion is a snapshot of a workflow from its version history
+type WorkflowVersion struct {
+	VersionId   string                 `json:"versionId"`
+	WorkflowId  string                 `json:"workflowId"`
+	Name        *string                `json:"name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Nodes       []Node                 `json:"nodes"`
+	Connections map[string]interface{} `json:"connections"`
+	Authors     string                 `json:"authors"`
+	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// WorkflowVersionRefs holds the version IDs of a workflow, which the generated
+// Workflow type does not include. VersionId is the version being edited and
+// ActiveVersion the published version, if any.
+type WorkflowVersionRefs struct {
+	VersionId     string `json:"versionId"`
+	ActiveVersion *struct {
+		VersionId string `json:"versionId"`
+	} `json:"activeVersion,omitempty"`
+}
+
+// CredentialUpdate is the body of a credential update. Empty fields are left
+// unchanged. With IsPartialData the data is merged into the existing fields of
+// the credential, otherwise it replaces them.
+type CredentialUpdate struct {
+	Name          string                 `json:"name,omitempty"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+	IsPartialData bool                   `json:"isPartialData,omitempty"`
+}
@@ -0,0 +1,83 @@
+package n8n
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by APIError through errors.Is
+var (
+	// ErrNotFound is matched by API errors with status 404
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is matched by API errors with status 401
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrConflict is matched by API errors with status 409
+	ErrConflict = errors.New("conflict")
+)
+
+// APIError is returned when the n8n API responds with an unexpected status code
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Method is the HTTP method of the request
+	Method string
+	// Path is the URL path of the request
+	Path string
+	// Message is the error message reported by n8n, if the body could be parsed
+	Message string
+	// Body is the raw response body
+	Body []byte
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	detail := e.Message
+	if detail == "" {
+		detail = strings.TrimSpace(string(e.Body))
+	}
+
+	return fmt.Sprintf("API returned error %d for %s %s: %s", e.StatusCode, e.Method, e.Path, detail)
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	default:
+		return false
+	}
+}
+
+// newAPIError builds an APIError from a response and its already consumed body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+
+	var payload struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Message = payload.Message
+		if apiErr.Message == "" {
+			apiErr.Message = payload.Error
+		}
+	}
+
+	return apiErr
+}
@@ -0,0 +1,158 @@
+package n8n
+
+import (
+	"sort"
+	"time"
+)
+
+// NodeRun is one run of a node, taken from the run data of an execution
+type NodeRun struct {
+	Node          string
+	RunIndex      int
+	StartTime     time.Time
+	ExecutionTime time.Duration
+	Status        string
+	ItemsIn       int
+	ItemsOut      int
+	Output        map[string]interface{}
+	Error         *NodeRunError
+}
+
+// NodeRunError is the error a node failed with
+type NodeRunError struct {
+	Message     string `json:"message"`
+	Description string `json:"description,omitempty"`
+	Stack       string `json:"stack,omitempty"`
+}
+
+// ExecutionNodeRuns walks the run data of an execution fetched with its data and
+// returns the runs of its nodes in execution order, along with the name of the
+// node that was executed last
+func ExecutionNodeRuns(execution Execution) ([]NodeRun, string) {
+	if execution.Data == nil {
+		return nil, ""
+	}
+	resultData, ok := (*execution.Data)["resultData"].(map[string]interface{})
+	if !ok {
+		return nil, ""
+	}
+
+	lastNode, _ := resultData["lastNodeExecuted"].(string)
+	runData, ok := resultData["runData"].(map[string]interface{})
+	if !ok {
+		return nil, lastNode
+	}
+
+	var runs []NodeRun
+	for nodeName, nodeData := range runData {
+		dataArray, ok := nodeData.([]interface{})
+		if !ok {
+			continue
+		}
+		for runIndex, runValue := range dataArray {
+			run, ok := runValue.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			startTime, ok := run["startTime"].(float64)
+			if !ok {
+				continue
+			}
+
+			nodeRun := NodeRun{
+				Node:      nodeName,
+				RunIndex:  runIndex,
+				StartTime: time.UnixMilli(int64(startTime)),
+				ItemsIn:   countInputItems(runData, run),
+			}
+			if executionTime, ok := run["executionTime"].(float64); ok {
+				nodeRun.ExecutionTime = time.Duration(executionTime) * time.Millisecond
+			}
+			nodeRun.Status, _ = run["executionStatus"].(string)
+			if output, ok := run["data"].(map[string]interface{}); ok {
+				nodeRun.Output = output
+				nodeRun.ItemsOut = countItems(output)
+			}
+			if runError, ok := run["error"].(map[string]interface{}); ok {
+				nodeRun.Error = &NodeRunError{}
+				nodeRun.Error.Message, _ = runError["message"].(string)
+				nodeRun.Error.Description, _ = runError["description"].(string)
+				nodeRun.Error.Stack, _ = runError["stack"].(string)
+				if nodeRun.Status == "" {
+					nodeRun.Status = "error"
+				}
+			}
+			runs = append(runs, nodeRun)
+		}
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		if !runs[i].StartTime.Equal(runs[j].StartTime) {
+			return runs[i].StartTime.Before(runs[j].StartTime)
+		}
+		if runs[i].Node != runs[j].Node {
+			return runs[i].Node < runs[j].Node
+		}
+		return runs[i].RunIndex < runs[j].RunIndex
+	})
+
+	return runs, lastNode
+}
+
+// countItems counts the items of all connection types and outputs of a node run
+func countItems(output map[string]interface{}) int {
+	count := 0
+	for _, connection := range output {
+		outputs, ok := connection.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, items := range outputs {
+			if items, ok := items.([]interface{}); ok {
+				count += len(items)
+			}
+		}
+	}
+	return count
+}
+
+// countInputItems counts the items a node run received by following its sources
+// to the outputs of the previous node runs
+func countInputItems(runData map[string]interface{}, run map[string]interface{}) int {
+	sources, ok := run["source"].([]interface{})
+	if !ok {
+		return 0
+	}
+
+	count := 0
+	for _, sourceValue := range sources {
+		source, ok := sourceValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		previousNode, _ := source["previousNode"].(string)
+		previousOutputValue, _ := source["previousNodeOutput"].(float64)
+		previousRunValue, _ := source["previousNodeRun"].(float64)
+		previousOutput, previousRun := int(previousOutputValue), int(previousRunValue)
+		if previousOutput < 0 || previousRun < 0 {
+			continue
+		}
+
+		previousRuns, ok := runData[previousNode].([]interface{})
+		if !ok || previousRun >= len(previousRuns) {
+			continue
+		}
+		previous, ok := previousRuns[previousRun].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		data, _ := previous["data"].(map[string]interface{})
+		outputs, _ := data["main"].([]interface{})
+		if previousOutput < len(outputs) {
+			if items, ok := outputs[previousOutput].([]interface{}); ok {
+				count += len(items)
+			}
+		}
+	}
+	return count
+}
@@ -1,44 +1,92 @@
 // Client is a simple client for interacting with n8n API
 package n8n
 
+import "context"
+
 // ClientInterface defines the contract for client objects
 //
 //go:generate go tool counterfeiter -o clientfakes/fake_client.go . ClientInterface
 type ClientInterface interface {
 	// GetWorkflows fetches workflows from the n8n API
-	GetWorkflows() (*WorkflowList, error)
+	GetWorkflows(ctx context.Context) (*WorkflowList, error)
 	// GetWorkflow fetches a single workflow by its ID
-	GetWorkflow(id string) (*Workflow, error)
+	GetWorkflow(ctx context.Context, id string) (*Workflow, error)
 	// ActivateWorkflow activates a workflow by its ID
-	ActivateWorkflow(id string) (*Workflow, error)
+	ActivateWorkflow(ctx context.Context, id string) (*Workflow, error)
 	// DeactivateWorkflow deactivates a workflow by its ID
-	DeactivateWorkflow(id string) (*Workflow, error)
+	DeactivateWorkflow(ctx context.Context, id string) (*Workflow, error)
 	// CreateWorkflow creates a new workflow
-	CreateWorkflow(workflow *Workflow) (*Workflow, error)
+	CreateWorkflow(ctx context.Context, workflow *Workflow) (*Workflow, error)
 	// UpdateWorkflow updates an existing workflow by its ID
-	UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error)
+	UpdateWorkflow(ctx context.Context, id string, workflow *Workflow) (*Workflow, error)
 	// DeleteWorkflow deletes a workflow by its ID
-	DeleteWorkflow(id string) error
+	DeleteWorkflow(ctx context.Context, id string) error
+	// GetWorkflowVersionRefs fetches the current and published version IDs of a workflow
+	GetWorkflowVersionRefs(ctx context.Context, id string) (*WorkflowVersionRefs, error)
+	// GetWorkflowVersion fetches a version of a workflow from its version history
+	GetWorkflowVersion(ctx context.Context, id string, versionID string) (*WorkflowVersion, error)
+	// TransferWorkflow transfers a workflow to another project
+	TransferWorkflow(ctx context.Context, id string, destinationProjectId string) error
 	// CreateCredential creates a new credential
-	CreateCredential(credential *Credential) (*CreateCredentialResponse, error)
+	CreateCredential(ctx context.Context, credential *Credential) (*CreateCredentialResponse, error)
+	// UpdateCredential updates the name and data of a credential by its ID
+	UpdateCredential(ctx context.Context, id string, update *CredentialUpdate) (*CreateCredentialResponse, error)
 	// DeleteCredential deletes a credential by its ID
-	DeleteCredential(id string) (*Credential, error)
+	DeleteCredential(ctx context.Context, id string) (*Credential, error)
 	// GetCredentialSchema fetches the schema for a credential type
-	GetCredentialSchema(credentialTypeName string) (map[string]interface{}, error)
+	GetCredentialSchema(ctx context.Context, credentialTypeName string) (map[string]interface{}, error)
 	// TransferCredential transfers a credential to another project
-	TransferCredential(id string, destinationProjectId string) error
+	TransferCredential(ctx context.Context, id string, destinationProjectId string) error
 	// GetExecutions fetches workflow executions from the n8n API
-	GetExecutions(workflowID string, includeData bool, status string, limit int, cursor string) (*ExecutionList, error)
+	GetExecutions(ctx context.Context, workflowID string, includeData bool, status string, limit int, cursor string) (*ExecutionList, error)
 	// GetExecutionById fetches a specific execution by its ID
-	GetExecutionById(executionID string, includeData bool) (*Execution, error)
+	GetExecutionById(ctx context.Context, executionID string, includeData bool) (*Execution, error)
+	// RetryExecution retries a failed execution and returns the new execution
+	RetryExecution(ctx context.Context, executionID string, loadWorkflow bool) (*Execution, error)
 	// GetWorkflowTags fetches the tags of a workflow by its ID
-	GetWorkflowTags(id string) (WorkflowTags, error)
+	GetWorkflowTags(ctx context.Context, id string) (WorkflowTags, error)
 	// UpdateWorkflowTags updates the tags of a workflow by its ID
-	UpdateWorkflowTags(id string, tagIds TagIds) (WorkflowTags, error)
+	UpdateWorkflowTags(ctx context.Context, id string, tagIds TagIds) (WorkflowTags, error)
 	// CreateTag creates a new tag in n8n
-	CreateTag(tagName string) (*Tag, error)
-	// GetTags fetches all tags from n8n
-	GetTags() (*TagList, error)
+	CreateTag(ctx context.Context, tagName string) (*Tag, error)
+	// GetTags fetches all tags from n8n, following the pagination cursor
+	GetTags(ctx context.Context) (*TagList, error)
+	// UpdateTag renames a tag by its ID
+	UpdateTag(ctx context.Context, id string, name string) (*Tag, error)
+	// DeleteTag deletes a tag by its ID
+	DeleteTag(ctx context.Context, id string) error
+	// GetVariables fetches all variables from n8n
+	GetVariables(ctx context.Context) (*VariableList, error)
+	// CreateVariable creates a new variable
+	CreateVariable(ctx context.Context, variable *Variable) error
+	// UpdateVariable updates an existing variable by its ID
+	UpdateVariable(ctx context.Context, id string, variable *Variable) error
+	// DeleteVariable deletes a variable by its ID
+	DeleteVariable(ctx context.Context, id string) error
+	// GetProjects fetches all projects from n8n
+	GetProjects(ctx context.Context) (*ProjectList, error)
+	// CreateProject creates a new team project
+	CreateProject(ctx context.Context, name string) (*Project, error)
+	// UpdateProject renames a project by its ID
+	UpdateProject(ctx context.Context, id string, name string) error
+	// DeleteProject deletes a project by its ID
+	DeleteProject(ctx context.Context, id string) error
+	// GetProjectMembers fetches the users of a project
+	GetProjectMembers(ctx context.Context, projectID string) (*UserList, error)
+	// AddProjectMembers adds users to a project with the given project roles
+	AddProjectMembers(ctx context.Context, projectID string, relations []ProjectRelation) error
+	// RemoveProjectMember removes a user from a project
+	RemoveProjectMember(ctx context.Context, projectID string, userID string) error
+	// GetUsers fetches all users of the instance
+	GetUsers(ctx context.Context) (*UserList, error)
+	// InviteUsers invites users to the instance
+	InviteUsers(ctx context.Context, invites []UserInvite) ([]UserInvitation, error)
+	// ChangeUserRole changes the global role of a user by its ID or email address
+	ChangeUserRole(ctx context.Context, idOrEmail string, role string) error
+	// DeleteUser deletes a user, optionally moving their data to another project
+	DeleteUser(ctx context.Context, idOrEmail string, transferProjectID string) error
+	// GenerateAudit generates a security audit of the instance
+	GenerateAudit(ctx context.Context, categories []string, daysAbandonedWorkflow int) (*Audit, error)
 }
 
 // Ensure Client implements ClientInterface
@@ -0,0 +1,89 @@
+package n8n
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultAPIPath is the path of the n8n public API relative to the instance URL
+const DefaultAPIPath = "/api/v1"
+
+// DefaultUserAgent is the User-Agent header sent when none is configured
+const DefaultUserAgent = "n8n-cli"
+
+// Option configures a Client created by NewClient
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests. Use it to
+// configure transports, proxies or TLS settings.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+	}
+}
+
+// WithLogger sets the logger used for debug and warning messages.
+// By default the client does not log anything.
+func WithLogger(logger *zap.SugaredLogger) Option {
+	return func(c *Client) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithAPIPath sets the path of the API relative to the instance URL.
+// It defaults to DefaultAPIPath.
+func WithAPIPath(path string) Option {
+	return func(c *Client) {
+		c.apiPath = path
+	}
+}
+
+// WithHeaders adds headers sent with every request. The Content-Type,
+// User-Agent and authentication headers set by the client take precedence.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		for key, value := range headers {
+			c.headers.Set(key, value)
+		}
+	}
+}
+
+// WithAuth adds authenticators applied to every request after the API key
+// header, e.g. for an API gateway in front of the instance
+func WithAuth(auth ...Authenticator) Option {
+	return func(c *Client) {
+		for _, a := range auth {
+			if a != nil {
+				c.auth = append(c.auth, a)
+			}
+		}
+	}
+}
+
+// WithTimeout sets the maximum duration of a single API call, including
+// reading the response body. A zero duration means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces the default retry policy of the client
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
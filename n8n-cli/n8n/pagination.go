@@ -0,0 +1,87 @@
+package n8n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// pageLimit is the number of items requested per page of a paginated collection
+const pageLimit = 100
+
+// page is one page of a paginated collection of the n8n API
+type page[T any] struct {
+	Data       []T     `json:"data"`
+	NextCursor *string `json:"nextCursor"`
+}
+
+// getAllPages fetches every page of the collection at collectionURL, following the
+// nextCursor of each page, and returns the items of all pages. params holds
+// additional query parameters, such as filters.
+func getAllPages[T any](ctx context.Context, c *Client, collectionURL string, params url.Values) ([]T, error) {
+	all := []T{}
+	seenCursors := make(map[string]struct{})
+	cursor := ""
+
+	for {
+		query := url.Values{}
+		for key, values := range params {
+			query[key] = values
+		}
+		query.Set("limit", strconv.Itoa(pageLimit))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		result, err := getPage[T](ctx, c, fmt.Sprintf("%s?%s", collectionURL, query.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, result.Data...)
+
+		if result.NextCursor == nil || *result.NextCursor == "" {
+			return all, nil
+		}
+
+		next := *result.NextCursor
+		if _, exists := seenCursors[next]; exists {
+			return nil, fmt.Errorf("pagination cursor repeated: %s", next)
+		}
+		seenCursors[next] = struct{}{}
+		cursor = next
+	}
+}
+
+// getPage fetches a single page of a paginated collection
+func getPage[T any](ctx context.Context, c *Client, pageURL string) (*page[T], error) {
+	req, err := c.newRequest(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Warnf("Error closing response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp, body)
+	}
+
+	var result page[T]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
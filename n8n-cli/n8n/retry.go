@@ -0,0 +1,223 @@
+package n8n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that failed with a
+// transient error (network errors, 429, 502, 503 and 504 responses)
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the initial backoff delay, doubled on every attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay and any Retry-After value sent by the server
+	MaxDelay time.Duration
+	// RetryNonIdempotent enables retries for POST and PATCH requests
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by new clients
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// allowsMethod reports whether requests with the given method may be retried
+func (p RetryPolicy) allowsMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return p.RetryNonIdempotent
+	}
+}
+
+// delay returns how long to wait before the next attempt. A Retry-After header
+// on the response takes precedence over the jittered exponential backoff.
+func (p RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxDelay > 0 && retryAfter > p.MaxDelay {
+				return p.MaxDelay
+			}
+			return retryAfter
+		}
+	}
+
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	backoff := p.BaseDelay << (attempt - 1)
+	if backoff <= 0 || (p.MaxDelay > 0 && backoff > p.MaxDelay) {
+		backoff = p.MaxDelay
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// isRetryable reports whether a request that produced resp and err should be attempted again
+func isRetryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// do sends the request and retries transient failures according to the retry policy
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	maxAttempts := c.retryPolicy.MaxAttempts
+	if maxAttempts < 1 || !c.retryPolicy.allowsMethod(req.Method) || (req.Body != nil && req.GetBody == nil) {
+		maxAttempts = 1
+	}
+
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.invalidateAuth() {
+			// A cached token may have been revoked before it expired, the request
+			// was rejected before it was processed, so it is sent once more with
+			// a fresh token
+			reauthenticated = true
+			next, authErr := c.reauthenticate(req)
+			if authErr != nil {
+				c.discardBody(resp)
+				return nil, authErr
+			}
+			if next != nil {
+				c.discardBody(resp)
+				c.logDebug("Retrying %s %s with a fresh token: %s", req.Method, req.URL.Path, resp.Status)
+				req = next
+				attempt--
+				continue
+			}
+		}
+
+		if attempt >= maxAttempts || !isRetryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait := c.retryPolicy.delay(attempt, resp)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			c.discardBody(resp)
+		}
+
+		c.logDebug("Retrying %s %s in %s (attempt %d/%d): %s", req.Method, req.URL.Path, wait, attempt+1, maxAttempts, reason)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		next := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			next.Body = body
+		}
+		req = next
+	}
+}
+
+// invalidateAuth drops the credentials cached by the authenticators, it reports
+// whether any authenticator caches credentials
+func (c *Client) invalidateAuth() bool {
+	invalidated := false
+	for _, auth := range c.auth {
+		if cached, ok := auth.(Invalidator); ok {
+			cached.Invalidate()
+			invalidated = true
+		}
+	}
+	return invalidated
+}
+
+// reauthenticate returns a copy of req authenticated again, or nil when the body
+// of req cannot be sent twice
+func (c *Client) reauthenticate(req *http.Request) (*http.Request, error) {
+	if req.Body != nil && req.GetBody == nil {
+		return nil, nil
+	}
+
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+
+	for _, auth := range c.auth {
+		if err := auth.Authenticate(next); err != nil {
+			return nil, fmt.Errorf("error authenticating request: %w", err)
+		}
+	}
+	return next, nil
+}
+
+// discardBody reads and closes the body of a response that is not returned
+func (c *Client) discardBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		c.logger.Warnf("Error closing response body: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package n8n
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TransportConfig describes the TLS and proxy settings of the HTTP transport
+type TransportConfig struct {
+	// CAFile is a PEM bundle of certificate authorities trusted in addition to the system pool
+	CAFile string
+	// ClientCert is a PEM client certificate presented for mutual TLS
+	ClientCert string
+	// ClientKey is the PEM private key of ClientCert
+	ClientKey string
+	// InsecureSkipVerify disables verification of the server certificate
+	InsecureSkipVerify bool
+	// ProxyURL routes all requests through the given proxy instead of the
+	// one configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables
+	ProxyURL string
+}
+
+// IsZero reports whether the configuration leaves the default transport unchanged
+func (cfg TransportConfig) IsZero() bool {
+	return cfg == TransportConfig{}
+}
+
+// NewTransport builds an HTTP transport from cfg, starting from the settings
+// of http.DefaultTransport
+func NewTransport(cfg TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA file %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mutual TLS")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL %s: scheme must be http, https or socks5", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
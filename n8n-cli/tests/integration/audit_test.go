@@ -0,0 +1,155 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/audit"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const auditResponse = `{
+  "Credentials Risk Report": {
+    "risk": "credentials",
+    "sections": [{
+      "title": "Credentials not used in any workflow",
+      "description": "These credentials are not used in any workflow.",
+      "recommendation": "Consider deleting these credentials if you no longer need them.",
+      "location": [{"kind": "credential", "id": "1", "name": "My Test Account"}]
+    }]
+  },
+  "Nodes Risk Report": {
+    "risk": "nodes",
+    "sections": [{
+      "title": "Community nodes",
+      "description": "This node is sourced from the community.",
+      "recommendation": "Consider reviewing the source code in any community nodes.",
+      "location": [{"kind": "community", "nodeType": "n8n-nodes-test.test", "packageUrl": "https://www.npmjs.com/package/n8n-nodes-test"}]
+    }, {
+      "title": "Official risky nodes",
+      "description": "These nodes are part of n8n's official nodes and may be used to fetch and run any arbitrary code in the host system.",
+      "recommendation": "Consider reviewing the parameters in these nodes.",
+      "location": [{"kind": "node", "workflowId": "7", "workflowName": "Import", "nodeId": "n1", "nodeName": "Run Script", "nodeType": "n8n-nodes-base.executeCommand"}]
+    }]
+  }
+}`
+
+func newAuditServer(t *testing.T, response string, request *map[string]interface{}) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/audit" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(request)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+}
+
+func TestAudit_Table(t *testing.T) {
+	var request map[string]interface{}
+	newAuditServer(t, auditResponse, &request)
+
+	stdout, _, err := executeCommand(t, audit.AuditCmd, "--categories", "credentials,nodes", "--days-abandoned-workflow", "30", "--fail-on", "none", "-o", "table")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"additionalOptions": map[string]interface{}{
+		"categories":            []interface{}{"credentials", "nodes"},
+		"daysAbandonedWorkflow": float64(30),
+	}}, request)
+
+	assert.Regexp(t, `SEVERITY\s+CATEGORY\s+FINDING\s+LOCATION`, stdout)
+	assert.Regexp(t, `high\s+nodes\s+Community nodes\s+community node n8n-nodes-test.test \(https://www.npmjs.com/package/n8n-nodes-test\)\n`+
+		`medium\s+nodes\s+Official risky nodes\s+workflow 'Import' \(ID: 7\), node 'Run Script' \(n8n-nodes-base.executeCommand\)\n`+
+		`low\s+credentials\s+Credentials not used in any workflow\s+credential 'My Test Account' \(ID: 1\)`, stdout)
+	assert.Contains(t, stdout, "  - Community nodes: Consider reviewing the source code in any community nodes.")
+	assert.Contains(t, stdout, "3 finding(s): 1 high, 1 medium, 1 low")
+}
+
+func TestAudit_FailOn(t *testing.T) {
+	var request map[string]interface{}
+	newAuditServer(t, auditResponse, &request)
+
+	// Slice flags keep their values between executions
+	require.NoError(t, audit.AuditCmd.Flags().Lookup("categories").Value.(pflag.SliceValue).Replace(nil))
+
+	stdout, _, err := executeCommand(t, audit.AuditCmd, "--days-abandoned-workflow", "0", "--fail-on", "medium", "-o", "json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "audit found 2 risk(s) with medium severity or higher")
+	var exitErr *rootcmd.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 2, exitErr.Code)
+	assert.Empty(t, request, "No options are sent by default")
+
+	var findings []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &findings))
+	require.Len(t, findings, 3)
+	assert.Equal(t, "high", findings[0]["severity"])
+	assert.Equal(t, "nodes", findings[0]["category"])
+
+	newAuditServer(t, "[]", &request)
+	stdout, _, err = executeCommand(t, audit.AuditCmd, "--fail-on", "low", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "No risks found")
+
+	_, _, err = executeCommand(t, audit.AuditCmd, "--fail-on", "critical")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid severity 'critical'")
+}
+
+func TestAudit_SARIF(t *testing.T) {
+	var request map[string]interface{}
+	newAuditServer(t, auditResponse, &request)
+
+	stdout, _, err := executeCommand(t, audit.AuditCmd, "--fail-on", "none", "-o", "sarif")
+	require.NoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID                   string `json:"id"`
+						DefaultConfiguration struct {
+							Level string `json:"level"`
+						} `json:"defaultConfiguration"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					LogicalLocations []struct {
+						Name string `json:"name"`
+						Kind string `json:"kind"`
+					} `json:"logicalLocations"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 3)
+	assert.Equal(t, "nodes/community-nodes", log.Runs[0].Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "error", log.Runs[0].Tool.Driver.Rules[0].DefaultConfiguration.Level)
+
+	require.Len(t, log.Runs[0].Results, 3)
+	result := log.Runs[0].Results[2]
+	assert.Equal(t, "credentials/credentials-not-used-in-any-workflow", result.RuleID)
+	assert.Equal(t, "note", result.Level)
+	require.Len(t, result.Locations, 1)
+	assert.Equal(t, "credential 'My Test Account' (ID: 1)", result.Locations[0].LogicalLocations[0].Name)
+	assert.Equal(t, "credential", result.Locations[0].LogicalLocations[0].Kind)
+}
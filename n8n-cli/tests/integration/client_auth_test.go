@@ -0,0 +1,225 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTokenScript writes a shell script printing output and counting its runs in a file
+func writeTokenScript(t *testing.T, output string) (string, func() int) {
+	dir := t.TempDir()
+	countFile := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "token.sh")
+
+	content := fmt.Sprintf("#!/bin/sh\necho run >> %s\ncat <<'EOF'\n%s\nEOF\n", countFile, output)
+	require.NoError(t, os.WriteFile(script, []byte(content), 0700))
+
+	runs := func() int {
+		data, err := os.ReadFile(countFile)
+		if err != nil {
+			return 0
+		}
+		return strings.Count(string(data), "run")
+	}
+
+	return script, runs
+}
+
+func TestClientAuth_StaticAuthenticators(t *testing.T) {
+	server, recorded := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(
+		n8n.HeaderAuth{"CF-Access-Client-Id": "client-id", "CF-Access-Client-Secret": "client-secret"},
+		n8n.BearerTokenAuth("gateway-token"),
+	))
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "test-api-key", recorded.Header.Get("X-N8N-API-KEY"), "The API key should still be sent")
+	assert.Equal(t, "client-id", recorded.Header.Get("CF-Access-Client-Id"))
+	assert.Equal(t, "client-secret", recorded.Header.Get("CF-Access-Client-Secret"))
+	assert.Equal(t, "Bearer gateway-token", recorded.Header.Get("Authorization"))
+}
+
+func TestClientAuth_ExecTokenCachedUntilExpiry(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	script, runs := writeTokenScript(t, fmt.Sprintf(`{"token": "exec-token", "expiry": %q}`, expiry))
+
+	server, recorded := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(n8n.NewExecTokenAuth(script)))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetWorkflow(context.Background(), "1")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "Bearer exec-token", recorded.Header.Get("Authorization"))
+	assert.Equal(t, 1, runs(), "The token command should only run once while the token is valid")
+}
+
+func TestClientAuth_ExecTokenRefreshedWhenExpired(t *testing.T) {
+	expiry := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	script, runs := writeTokenScript(t, fmt.Sprintf(`{"token": "expired-token", "expiry": %q}`, expiry))
+
+	server, _ := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(n8n.NewExecTokenAuth(script)))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetWorkflow(context.Background(), "1")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, runs())
+}
+
+func TestClientAuth_ExecTokenRefreshedWhenRejected(t *testing.T) {
+	dir := t.TempDir()
+	countFile := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "token.sh")
+	// Prints token-1 on the first run, token-2 on the second and so on
+	content := fmt.Sprintf("#!/bin/sh\necho run >> %s\necho token-$(wc -l < %s | tr -d ' ')\n", countFile, countFile)
+	require.NoError(t, os.WriteFile(script, []byte(content), 0700))
+
+	var mu sync.Mutex
+	valid := "Bearer token-2"
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"message": "token revoked"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"id": "1", "name": "Workflow"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(n8n.NewExecTokenAuth(script)))
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err, "A rejected token should be replaced by running the command again")
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, seen)
+
+	_, err = client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-2", seen[len(seen)-1], "The fresh token should be cached")
+
+	// Still rejected with a fresh token: the 401 is returned after one more attempt
+	mu.Lock()
+	valid = "Bearer never"
+	seen = nil
+	mu.Unlock()
+	_, err = client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrUnauthorized)
+	assert.Equal(t, []string{"Bearer token-2", "Bearer token-3"}, seen)
+}
+
+func TestClientAuth_ExecTokenPlainOutputAndCustomHeader(t *testing.T) {
+	script, runs := writeTokenScript(t, "plain-token")
+
+	auth := n8n.NewExecTokenAuth(script)
+	auth.Header = "cf-access-token"
+
+	server, recorded := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(auth))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetWorkflow(context.Background(), "1")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "plain-token", recorded.Header.Get("cf-access-token"))
+	assert.Empty(t, recorded.Header.Get("Authorization"))
+	assert.Equal(t, 1, runs(), "Tokens without expiry should be cached")
+}
+
+func TestClientAuth_ExecTokenFailure(t *testing.T) {
+	server, _ := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithAuth(n8n.NewExecTokenAuth("sh", "-c", "echo 'not logged in' >&2; exit 1")))
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not logged in")
+}
+
+func TestNewAPIClient_AuthFromConfig(t *testing.T) {
+	server, recorded := setupRecordingServer(t)
+
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		header      string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "Bearer token",
+			config:   map[string]interface{}{"type": "bearer", "token": "config-token"},
+			header:   "Authorization",
+			expected: "Bearer config-token",
+		},
+		{
+			name:     "Static headers",
+			config:   map[string]interface{}{"type": "header", "headers": map[string]string{"CF-Access-Client-Id": "client-id"}},
+			header:   "CF-Access-Client-Id",
+			expected: "client-id",
+		},
+		{
+			name:     "Exec token",
+			config:   map[string]interface{}{"type": "exec", "command": "echo", "args": []string{"exec-token"}},
+			header:   "Authorization",
+			expected: "Bearer exec-token",
+		},
+		{
+			name:        "Missing bearer token",
+			config:      map[string]interface{}{"type": "bearer"},
+			expectedErr: "auth type bearer requires auth.token",
+		},
+		{
+			name:        "Unsupported type",
+			config:      map[string]interface{}{"type": "oauth"},
+			expectedErr: `unsupported auth type "oauth"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTestConfig(t, server.URL, "test-api-key")
+			defer teardownTestConfig()
+			viper.Set("auth", tc.config)
+
+			client, err := rootcmd.NewAPIClient(context.Background())
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = client.GetWorkflow(context.Background(), "1")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, recorded.Header.Get(tc.header))
+			assert.Equal(t, "test-api-key", recorded.Header.Get("X-N8N-API-KEY"))
+		})
+	}
+}
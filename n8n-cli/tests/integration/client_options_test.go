@@ -0,0 +1,77 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRecordingServer creates a test server that records the last request it received
+func setupRecordingServer(t *testing.T) (*httptest.Server, *http.Request) {
+	recorded := &http.Request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*recorded = *r.Clone(context.Background())
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"id": "1", "name": "Workflow"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, recorded
+}
+
+func TestClientOptions_DefaultRequest(t *testing.T) {
+	server, recorded := setupRecordingServer(t)
+	client := n8n.NewClient(server.URL, "test-api-key")
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1/workflows/1", recorded.URL.Path)
+	assert.Equal(t, "test-api-key", recorded.Header.Get("X-N8N-API-KEY"))
+	assert.Equal(t, n8n.DefaultUserAgent, recorded.Header.Get("User-Agent"))
+}
+
+func TestClientOptions_CustomRequest(t *testing.T) {
+	server, recorded := setupRecordingServer(t)
+
+	var transportCalls int
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		transportCalls++
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	client := n8n.NewClient(server.URL+"/n8n", "test-api-key",
+		n8n.WithHTTPClient(httpClient),
+		n8n.WithAPIPath("/custom/api"),
+		n8n.WithUserAgent("n8n-cli/1.2.3"),
+		n8n.WithHeaders(map[string]string{
+			"X-Request-Source": "ci",
+			"X-N8N-API-KEY":    "overridden",
+		}),
+	)
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+
+	assert.Equal(t, server.URL+"/n8n/custom/api", client.BaseURL())
+	assert.Equal(t, "/n8n/custom/api/workflows/1", recorded.URL.Path)
+	assert.Equal(t, "n8n-cli/1.2.3", recorded.Header.Get("User-Agent"))
+	assert.Equal(t, "ci", recorded.Header.Get("X-Request-Source"))
+	assert.Equal(t, "test-api-key", recorded.Header.Get("X-N8N-API-KEY"), "Custom headers must not override authentication")
+	assert.Equal(t, 1, transportCalls, "Requests should go through the provided HTTP client")
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
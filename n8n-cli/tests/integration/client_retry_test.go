@@ -0,0 +1,137 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupFlakyServer creates a test server that fails the first failures requests with statusCode
+func setupFlakyServer(t *testing.T, failures int32, statusCode int, retryAfter string) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&calls, 1)
+
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPut && len(body) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"message": "empty body on retry"}`)
+			return
+		}
+
+		if call <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statusCode)
+			_, _ = fmt.Fprint(w, `{"message": "temporarily unavailable"}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/workflows":
+			_, _ = fmt.Fprint(w, `{"data": [{"id": "1", "name": "Workflow"}], "nextCursor": null}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"id": "1", "name": "Workflow"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newRetryingClient(serverURL string, attempts int) *n8n.Client {
+	return n8n.NewClient(serverURL, "test-api-key", n8n.WithRetryPolicy(n8n.RetryPolicy{
+		MaxAttempts: attempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	}))
+}
+
+func TestClientRetry_RecoversFromTransientErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		retryAfter string
+	}{
+		{name: "Bad gateway", statusCode: http.StatusBadGateway},
+		{name: "Service unavailable", statusCode: http.StatusServiceUnavailable},
+		{name: "Too many requests with Retry-After", statusCode: http.StatusTooManyRequests, retryAfter: "0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server, calls := setupFlakyServer(t, 2, tc.statusCode, tc.retryAfter)
+			client := newRetryingClient(server.URL, 3)
+
+			workflows, err := client.GetWorkflows(context.Background())
+			require.NoError(t, err)
+			require.NotNil(t, workflows.Data)
+			assert.Len(t, *workflows.Data, 1)
+			assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+		})
+	}
+}
+
+func TestClientRetry_ResendsRequestBody(t *testing.T) {
+	server, calls := setupFlakyServer(t, 1, http.StatusServiceUnavailable, "")
+	client := newRetryingClient(server.URL, 3)
+
+	workflow, err := client.UpdateWorkflow(context.Background(), "1", &n8n.Workflow{Name: "Workflow"})
+	require.NoError(t, err)
+	assert.Equal(t, "Workflow", workflow.Name)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestClientRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := setupFlakyServer(t, 10, http.StatusServiceUnavailable, "")
+	client := newRetryingClient(server.URL, 3)
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestClientRetry_SkipsNonIdempotentMethodsByDefault(t *testing.T) {
+	server, calls := setupFlakyServer(t, 1, http.StatusServiceUnavailable, "")
+	client := newRetryingClient(server.URL, 3)
+
+	_, err := client.CreateWorkflow(context.Background(), &n8n.Workflow{Name: "Workflow"})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestClientRetry_RetriesNonIdempotentMethodsWhenEnabled(t *testing.T) {
+	server, calls := setupFlakyServer(t, 1, http.StatusServiceUnavailable, "")
+	client := n8n.NewClient(server.URL, "test-api-key", n8n.WithRetryPolicy(n8n.RetryPolicy{
+		MaxAttempts:        3,
+		BaseDelay:          time.Millisecond,
+		MaxDelay:           10 * time.Millisecond,
+		RetryNonIdempotent: true,
+	}))
+
+	_, err := client.CreateWorkflow(context.Background(), &n8n.Workflow{Name: "Workflow"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestClientRetry_DoesNotRetryClientErrors(t *testing.T) {
+	server, calls := setupFlakyServer(t, 1, http.StatusNotFound, "")
+	client := newRetryingClient(server.URL, 3)
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
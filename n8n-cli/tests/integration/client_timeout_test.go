@@ -0,0 +1,60 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSlowServer creates a test server that holds every request until the test ends
+func setupSlowServer(t *testing.T) *httptest.Server {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+
+	return server
+}
+
+func TestClient_TimeoutAbortsHungRequest(t *testing.T) {
+	server := setupSlowServer(t)
+
+	client := n8n.NewClient(server.URL, "test-api-key",
+		n8n.WithTimeout(50*time.Millisecond),
+		n8n.WithRetryPolicy(n8n.RetryPolicy{MaxAttempts: 1}),
+	)
+
+	start := time.Now()
+	_, err := client.GetWorkflows(context.Background())
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second, "Request should be aborted by the client timeout")
+}
+
+func TestClient_ContextCancellationAbortsRequest(t *testing.T) {
+	server := setupSlowServer(t)
+
+	client := n8n.NewClient(server.URL, "test-api-key")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.GetWorkflow(ctx, "123")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+}
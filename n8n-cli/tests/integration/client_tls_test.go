@@ -0,0 +1,238 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCertificate is a certificate with its key, signed by a test CA
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newTestCertificate creates a certificate signed by parent, or a self-signed CA when parent is nil
+func newTestCertificate(t *testing.T, commonName string, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// writeTestFile writes content to a file in a temporary directory and returns its path
+func writeTestFile(t *testing.T, name string, content []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	return path
+}
+
+// workflowHandler answers every request with a single workflow
+func workflowHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"id": "1", "name": "Workflow"}`)
+}
+
+// setupTLSServer starts a TLS server using a certificate signed by a self-signed test CA
+func setupTLSServer(t *testing.T, clientCA *testCertificate) (*httptest.Server, string) {
+	ca := newTestCertificate(t, "n8n test CA", nil)
+	serverCert := newTestCertificate(t, "localhost", ca)
+
+	certificate, err := tls.X509KeyPair(serverCert.certPEM, serverCert.keyPEM)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(workflowHandler))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA.cert)
+		server.TLS.ClientCAs = pool
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server, writeTestFile(t, "ca.pem", ca.certPEM)
+}
+
+// newTransportClient creates a client for serverURL using the given transport configuration
+func newTransportClient(t *testing.T, serverURL string, cfg n8n.TransportConfig) *n8n.Client {
+	transport, err := n8n.NewTransport(cfg)
+	require.NoError(t, err)
+
+	return n8n.NewClient(serverURL, "test-api-key",
+		n8n.WithHTTPClient(&http.Client{Transport: transport}),
+		n8n.WithRetryPolicy(n8n.RetryPolicy{MaxAttempts: 1}),
+	)
+}
+
+func TestClientTLS_RejectsUnknownCA(t *testing.T) {
+	server, _ := setupTLSServer(t, nil)
+	client := newTransportClient(t, server.URL, n8n.TransportConfig{})
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+}
+
+func TestClientTLS_TrustsCAFile(t *testing.T) {
+	server, caFile := setupTLSServer(t, nil)
+	client := newTransportClient(t, server.URL, n8n.TransportConfig{CAFile: caFile})
+
+	workflow, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Workflow", workflow.Name)
+}
+
+func TestClientTLS_InsecureSkipVerify(t *testing.T) {
+	server, _ := setupTLSServer(t, nil)
+	client := newTransportClient(t, server.URL, n8n.TransportConfig{InsecureSkipVerify: true})
+
+	_, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+}
+
+func TestClientTLS_MutualTLS(t *testing.T) {
+	clientCA := newTestCertificate(t, "n8n client CA", nil)
+	clientCert := newTestCertificate(t, "n8n-cli", clientCA)
+	server, caFile := setupTLSServer(t, clientCA)
+
+	t.Run("Without client certificate", func(t *testing.T) {
+		client := newTransportClient(t, server.URL, n8n.TransportConfig{CAFile: caFile})
+
+		_, err := client.GetWorkflow(context.Background(), "1")
+		require.Error(t, err)
+	})
+
+	t.Run("With client certificate", func(t *testing.T) {
+		client := newTransportClient(t, server.URL, n8n.TransportConfig{
+			CAFile:     caFile,
+			ClientCert: writeTestFile(t, "client.pem", clientCert.certPEM),
+			ClientKey:  writeTestFile(t, "client-key.pem", clientCert.keyPEM),
+		})
+
+		workflow, err := client.GetWorkflow(context.Background(), "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Workflow", workflow.Name)
+	})
+}
+
+func TestClientTLS_ProxyURL(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		workflowHandler(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client := newTransportClient(t, "http://n8n.internal.example.com", n8n.TransportConfig{ProxyURL: proxy.URL})
+
+	workflow, err := client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Workflow", workflow.Name)
+	assert.Equal(t, "n8n.internal.example.com", proxiedHost)
+}
+
+func TestNewTransport_InvalidConfig(t *testing.T) {
+	invalidPEM := writeTestFile(t, "invalid.pem", []byte("not a certificate"))
+
+	testCases := []struct {
+		name     string
+		cfg      n8n.TransportConfig
+		expected string
+	}{
+		{"Missing CA file", n8n.TransportConfig{CAFile: "/nonexistent/ca.pem"}, "error reading CA file"},
+		{"CA file without certificates", n8n.TransportConfig{CAFile: invalidPEM}, "no PEM certificates found"},
+		{"Client certificate without key", n8n.TransportConfig{ClientCert: invalidPEM}, "both a client certificate and a client key are required"},
+		{"Invalid client certificate", n8n.TransportConfig{ClientCert: invalidPEM, ClientKey: invalidPEM}, "error loading client certificate"},
+		{"Unsupported proxy scheme", n8n.TransportConfig{ProxyURL: "ftp://proxy:21"}, "scheme must be http, https or socks5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := n8n.NewTransport(tc.cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+func TestNewAPIClient_UsesTLSConfiguration(t *testing.T) {
+	server, caFile := setupTLSServer(t, nil)
+	setupTestConfig(t, server.URL, "test-api-key")
+	defer teardownTestConfig()
+	viper.Set("retry_max_attempts", 1)
+
+	client, err := rootcmd.NewAPIClient(context.Background())
+	require.NoError(t, err)
+	_, err = client.GetWorkflow(context.Background(), "1")
+	require.Error(t, err, "The test CA should not be trusted without ca_file")
+
+	viper.Set("ca_file", caFile)
+	client, err = rootcmd.NewAPIClient(context.Background())
+	require.NoError(t, err)
+	_, err = client.GetWorkflow(context.Background(), "1")
+	require.NoError(t, err)
+
+	viper.Set("ca_file", "/nonexistent/ca.pem")
+	_, err = rootcmd.NewAPIClient(context.Background())
+	require.Error(t, err)
+}
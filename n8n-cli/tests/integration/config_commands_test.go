@@ -0,0 +1,93 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"os"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigView_RedactsSecrets(t *testing.T) {
+	t.Chdir(t.TempDir())
+	setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+
+	stdout, _, err := executeCommand(t, config.ViewCmd, "-o", "yaml")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "instance_url: http://dev:5678")
+	assert.Contains(t, stdout, "context: dev")
+	assert.Contains(t, stdout, "api_key: '********'")
+	assert.NotContains(t, stdout, "dev-key")
+	assert.NotContains(t, stdout, "prod-key")
+}
+
+func TestConfigSetUnset(t *testing.T) {
+	t.Chdir(t.TempDir())
+	configFile := setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "config", "set", "contexts.prod.retry_max_attempts", "5")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Set contexts.prod.retry_max_attempts in "+configFile)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "config", "set", "timeout", "soon")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout must be a duration")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "config", "unset", "contexts.dev.api_key")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Unset contexts.dev.api_key")
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# n8n instances")
+	assert.Contains(t, string(content), "    api_key: prod-key\n    retry_max_attempts: 5\n")
+	assert.NotContains(t, string(content), "dev-key")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "config", "unset", "contexts.dev.api_key")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contexts.dev.api_key is not set")
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	configFile := setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+
+	stdout, _, err := executeCommand(t, config.ValidateCmd)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Configuration is valid")
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(configFile, append(content, []byte("instance-url: n8n.example.com\nproxy_url: ftp://proxy\n")...), 0600))
+
+	stdout, _, err = executeCommand(t, config.ValidateCmd)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "configuration has 2 problem(s)")
+	assert.Contains(t, stdout, `instance-url: unknown configuration key "instance-url", did you mean "instance_url"?`)
+	assert.Contains(t, stdout, `proxy_url: proxy_url must use the http, https, socks5 scheme, got "ftp://proxy"`)
+}
+
+func TestConfigExplain(t *testing.T) {
+	t.Chdir(t.TempDir())
+	configFile := setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+	t.Setenv("N8N_API_KEY", "env-key")
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "config", "explain", "api_key", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `SOURCE\s+ORIGIN\s+VALUE\s+STATUS`, stdout)
+	assert.Regexp(t, `env\s+N8N_API_KEY\s+\*{8}\s+active`, stdout)
+	assert.Regexp(t, `context\s+dev\s+\*{8}\s+overridden`, stdout)
+	assert.NotContains(t, stdout, "env-key")
+	assert.NotContains(t, stdout, "dev-key")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "config", "explain", "instance_url", "--context", "prod", "-o", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"source": "context", "origin": "prod", "value": "https://prod.example.com", "status": "active"},
+		{"source": "default", "origin": "--url", "value": "http://localhost:5678", "status": "overridden"}
+	]`, stdout)
+	assert.NotContains(t, stdout, configFile, "Instance URLs are only defined inside the contexts")
+}
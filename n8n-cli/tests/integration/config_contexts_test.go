@@ -0,0 +1,143 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/config"
+	"github.com/edenreich/n8n-cli/cmd/workflows"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupContextsHome writes a config file with a dev and a prod context into a temporary HOME
+func setupContextsHome(t *testing.T, devURL, prodURL string) string {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("N8N_API_KEY", "")
+	t.Setenv("N8N_INSTANCE_URL", "")
+	t.Setenv("N8N_CONTEXT", "")
+
+	configFile := filepath.Join(home, ".n8n", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configFile), 0700))
+	content := fmt.Sprintf(`# n8n instances
+current-context: dev
+contexts:
+  dev:
+    instance_url: %s
+    api_key: dev-key
+  prod:
+    instance_url: %s
+    api_key: prod-key
+`, devURL, prodURL)
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	viper.Reset()
+	require.NoError(t, viper.BindPFlag("context", rootcmd.GetRootCmd().PersistentFlags().Lookup("context")))
+	t.Cleanup(func() {
+		viper.Reset()
+		_ = rootcmd.GetRootCmd().PersistentFlags().Set("context", "")
+	})
+
+	return configFile
+}
+
+// setupContextServer creates a test server answering the workflows list with the API key it received
+func setupContextServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data": [{"id": "1", "name": "Seen with %s"}], "nextCursor": null}`, r.Header.Get("X-N8N-API-KEY"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConfigGetContexts(t *testing.T) {
+	setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+
+	stdout, _, err := executeCommand(t, config.GetContextsCmd)
+	require.NoError(t, err)
+	assert.Regexp(t, `CURRENT\s+NAME\s+INSTANCE_URL`, stdout)
+	assert.Regexp(t, `\*\s+dev\s+http://dev:5678`, stdout)
+	assert.Regexp(t, `\n\s+prod\s+https://prod.example.com`, stdout)
+
+	stdout, _, err = executeCommand(t, config.GetContextsCmd, "--context", "prod", "-o", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"name": "dev", "instanceUrl": "http://dev:5678", "current": false},
+		{"name": "prod", "instanceUrl": "https://prod.example.com", "current": true}
+	]`, stdout)
+}
+
+func TestConfigUseContext(t *testing.T) {
+	configFile := setupContextsHome(t, "http://dev:5678", "https://prod.example.com")
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "config", "use-context", "prod")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `Switched to context "prod"`)
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "current-context: prod")
+	assert.Contains(t, string(content), "# n8n instances", "Comments should be preserved")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "config", "use-context", "staging")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `context "staging" not found`)
+}
+
+func TestContextFlagSelectsInstance(t *testing.T) {
+	dev := setupContextServer(t)
+	prod := setupContextServer(t)
+	setupContextsHome(t, dev.URL, prod.URL)
+
+	stdout, _, err := executeCommand(t, workflows.ListCmd)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Seen with dev-key")
+
+	stdout, _, err = executeCommand(t, workflows.ListCmd, "--context", "prod")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Seen with prod-key")
+
+	_, _, err = executeCommand(t, workflows.ListCmd, "--context", "staging")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `context "staging" not found`)
+}
+
+func TestContextFlagPrecedence(t *testing.T) {
+	dev := setupContextServer(t)
+	prod := setupContextServer(t)
+	configFile := setupContextsHome(t, dev.URL, prod.URL)
+
+	content := fmt.Sprintf(`api_key: topkey
+current-context: dev
+contexts:
+  dev:
+    instance_url: %s
+    api_key: dev-key
+  prod:
+    instance_url: %s
+    api_key_command: echo prodkey
+`, dev.URL, prod.URL)
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	t.Chdir(t.TempDir())
+	envFile := fmt.Sprintf("N8N_INSTANCE_URL=%s\nN8N_API_KEY=env-file-key\n", dev.URL)
+	require.NoError(t, os.WriteFile(".env", []byte(envFile), 0600))
+
+	stdout, _, err := executeCommand(t, workflows.ListCmd)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Seen with env-file-key", "The .env file overrides the current context")
+
+	stdout, _, err = executeCommand(t, workflows.ListCmd, "--context", "prod")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Seen with prodkey", "The selected context overrides the .env file and the top-level api_key")
+}
@@ -0,0 +1,74 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/cmd/config"
+	n8nconfig "github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dirFileReader opens files relative to a directory
+type dirFileReader struct {
+	dir string
+}
+
+func (r *dirFileReader) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(r.dir, name))
+}
+
+func TestConfigEncryptEnv(t *testing.T) {
+	t.Setenv(n8nconfig.PassphraseEnvVar, "correct horse")
+	t.Setenv("N8N_API_KEY", "")
+	t.Setenv("N8N_INSTANCE_URL", "")
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, ".env")
+	output := filepath.Join(dir, ".env.enc")
+	plain := "# n8n credentials\nN8N_API_KEY=\"s3cr3t-api-key\"\nN8N_INSTANCE_URL=http://localhost:5678\nN8N_EMPTY=\nN8N_ALREADY=" + "ENC(U2FsdGVkX1/CY7bK0pTJDOY6kVYVBCd+x5HBxckmbB0=)\n"
+	require.NoError(t, os.WriteFile(input, []byte(plain), 0600))
+
+	_, stderr, err := executeCommand(t, config.EncryptEnvCmd, "-i", input, "-o", output)
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "Encrypted 2 value(s)")
+
+	encrypted, err := os.ReadFile(output)
+	require.NoError(t, err)
+	lines := strings.Split(string(encrypted), "\n")
+	assert.Equal(t, "# n8n credentials", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "N8N_API_KEY=ENC("), "Expected encrypted API key, got %s", lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], "N8N_INSTANCE_URL=ENC("), "Expected encrypted instance URL, got %s", lines[2])
+	assert.Equal(t, "N8N_EMPTY=", lines[3])
+	assert.Equal(t, "N8N_ALREADY=ENC(U2FsdGVkX1/CY7bK0pTJDOY6kVYVBCd+x5HBxckmbB0=)", lines[4])
+	assert.NotContains(t, string(encrypted), "s3cr3t-api-key")
+
+	info, err := os.Stat(output)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	require.NoError(t, os.Rename(output, input))
+	v := viper.New()
+	n8nconfig.LoadEnvFileWithReader(&dirFileReader{dir: dir}, v)
+	require.NoError(t, n8nconfig.DecryptSettings(v))
+	assert.Equal(t, "s3cr3t-api-key", v.GetString("api_key"))
+	assert.Equal(t, "http://localhost:5678", v.GetString("instance_url"))
+	assert.Equal(t, "s3cr3t-api-key", v.GetString("already"))
+}
+
+func TestConfigEncryptEnv_RejectsSameOutput(t *testing.T) {
+	t.Setenv(n8nconfig.PassphraseEnvVar, "correct horse")
+
+	input := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(input, []byte("N8N_API_KEY=key\n"), 0600))
+
+	_, _, err := executeCommand(t, config.EncryptEnvCmd, "-i", input, "-o", input)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "input and output files must be different")
+}
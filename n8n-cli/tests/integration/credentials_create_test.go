@@ -0,0 +1,150 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	_ "github.com/edenreich/n8n-cli/cmd/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const slackSchema = `{
+  "additionalProperties": false,
+  "type": "object",
+  "properties": {
+    "accessToken": {"type": "string"},
+    "signatureSecret": {"type": "string"},
+    "retries": {"type": "integer"}
+  },
+  "required": ["accessToken"]
+}`
+
+// credentialsServer records the requests and the credentials that are created
+type credentialsServer struct {
+	requests []string
+	created  []map[string]interface{}
+}
+
+// newCredentialsServer serves the schema of the slackApi credential type
+func newCredentialsServer(t *testing.T) *credentialsServer {
+	state := &credentialsServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.requests = append(state.requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/credentials/schema/slackApi":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(slackSchema))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/credentials":
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			state.created = append(state.created, body)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "42", "name": body["name"], "type": body["type"]})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func TestCredentialsCreate_ValidatesData(t *testing.T) {
+	server := newCredentialsServer(t)
+
+	_, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data", `{"acessToken": "xoxb", "retries": "3"}`, "--data-file=", "--interactive=false", "--skip-validation=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid credential data: 3 problem(s)")
+	assert.Contains(t, stderr, "Credential data does not match the schema of type 'slackApi':")
+	assert.Contains(t, stderr, "  accessToken: is required")
+	assert.Contains(t, stderr, "  acessToken: is not a field of this credential type")
+	assert.Contains(t, stderr, "  retries: must be of type integer, got string")
+	assert.NotContains(t, stderr, "xoxb")
+	assert.Empty(t, server.created, "Invalid data is not sent")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data", `{"acessToken": "xoxb"}`, "--skip-validation")
+	require.NoError(t, err)
+	require.Len(t, server.created, 1)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "unknownApi",
+		"--data", `{"accessToken": "xoxb"}`, "--skip-validation=false")
+	require.Error(t, err)
+	assert.Len(t, server.created, 1)
+}
+
+func TestCredentialsCreate_Interactive(t *testing.T) {
+	server := newCredentialsServer(t)
+	rootcmd.GetRootCmd().SetIn(strings.NewReader("\nxoxb-token\nabc\n2\n\n"))
+	t.Cleanup(func() { rootcmd.GetRootCmd().SetIn(nil) })
+
+	stdout, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data=", "--data-file=", "--interactive", "--skip-validation=false", "-o", "text")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Credential created successfully")
+	assert.Contains(t, stderr, "accessToken (string; required): 'accessToken' is required")
+	assert.Contains(t, stderr, "retries (integer): Invalid value for 'retries': expected an integer")
+	assert.Contains(t, stderr, "signatureSecret (string): ")
+
+	require.Len(t, server.created, 1)
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-token", "retries": float64(2)}, server.created[0]["data"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data", `{"accessToken": "xoxb"}`, "--interactive")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use either --interactive or --data/--data-file")
+}
+
+func TestCredentialsCreate_SecretRefs(t *testing.T) {
+	server := newCredentialsServer(t)
+	t.Setenv("SLACK_TOKEN", "xoxb-from-env")
+	secretFile := filepath.Join(t.TempDir(), "signature_secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("from-file\n"), 0600))
+
+	data := fmt.Sprintf(`{"accessToken": "env:SLACK_TOKEN", "signatureSecret": "file:%s", "retries": 2}`, secretFile)
+	_, _, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data", data, "--data-file=", "--interactive=false", "--skip-validation=false")
+	require.NoError(t, err)
+	require.Len(t, server.created, 1)
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-from-env", "signatureSecret": "from-file", "retries": float64(2)}, server.created[0]["data"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi",
+		"--data", `{"accessToken": "exec:echo xoxb-from-exec"}`)
+	require.NoError(t, err)
+	require.Len(t, server.created, 2)
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-from-exec"}, server.created[1]["data"])
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "Unset variable", data: `{"accessToken": "env:MISSING_SLACK_TOKEN"}`, wantErr: "credential field 'accessToken': environment variable MISSING_SLACK_TOKEN is not set"},
+		{name: "Missing file", data: `{"accessToken": "file:/nonexistent/token"}`, wantErr: "credential field 'accessToken': error reading secret file"},
+		{name: "Failing command", data: `{"accessToken": "exec:echo denied >&2; exit 3"}`, wantErr: "credential field 'accessToken': secret command failed: exit status 3: denied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.requests = nil
+			_, _, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "create", "--name", "Slack", "--type", "slackApi", "--data", tt.data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, server.requests, "References are resolved before any API call")
+		})
+	}
+}
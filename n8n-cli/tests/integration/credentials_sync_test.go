@@ -0,0 +1,207 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	_ "github.com/edenreich/n8n-cli/cmd/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentialStoreServer is an in-memory n8n credentials API
+type credentialStoreServer struct {
+	mu          sync.Mutex
+	credentials map[string]map[string]interface{}
+	requests    []string
+	nextID      int
+}
+
+func newCredentialStoreServer(t *testing.T) *credentialStoreServer {
+	state := &credentialStoreServer{credentials: make(map[string]map[string]interface{}), nextID: 1}
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func (s *credentialStoreServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/api/v1/credentials/")
+	existing, exists := s.credentials[id]
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && id == "schema/slackApi":
+		_, _ = w.Write([]byte(slackSchema))
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/credentials":
+		id := fmt.Sprintf("c%d", s.nextID)
+		s.nextID++
+		body["id"] = id
+		s.credentials[id] = body
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "name": body["name"], "type": body["type"]})
+	case r.Method == http.MethodPatch && exists:
+		// Fields left out of the body are kept
+		for _, field := range []string{"name", "data"} {
+			if value, ok := body[field]; ok {
+				existing[field] = value
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "name": existing["name"], "type": existing["type"]})
+	case r.Method == http.MethodDelete && exists:
+		delete(s.credentials, id)
+		_ = json.NewEncoder(w).Encode(existing)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	}
+}
+
+// data returns the data of the credentials by name
+func (s *credentialStoreServer) data() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := make(map[string]interface{})
+	for _, credential := range s.credentials {
+		data[credential["name"].(string)] = credential["data"]
+	}
+	return data
+}
+
+func writeManifest(t *testing.T, dir, file, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content), 0600))
+}
+
+func TestCredentialsSync(t *testing.T) {
+	server := newCredentialStoreServer(t)
+	server.credentials["manual"] = map[string]interface{}{"id": "manual", "name": "Manual", "type": "slackApi", "data": "kept"}
+	t.Setenv("SLACK_TOKEN", "xoxb-alerts")
+
+	dir := t.TempDir()
+	writeManifest(t, dir, "alerts.yaml", "name: Slack Alerts\ntype: slackApi\ndata:\n  accessToken: env:SLACK_TOKEN\n  retries: 3\n")
+	writeManifest(t, dir, "reports.yml", "name: Slack Reports\ntype: slackApi\ndata:\n  accessToken: xoxb-reports\n")
+	writeManifest(t, dir, "README.md", "Not a manifest")
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--dry-run", "--prune=false", "--skip-validation=false", "--force=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Would create credential 'Slack Alerts'")
+	assert.Contains(t, stdout, "Credentials: 2 would be created, 0 would be updated, 0 would be deleted, 0 unchanged")
+	assert.NotContains(t, stdout, "xoxb")
+	assert.NoFileExists(t, filepath.Join(dir, ".n8n-credentials-state.yaml"))
+	assert.Len(t, server.credentials, 1)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--dry-run=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Created credential 'Slack Alerts' (ID: c1)")
+	assert.Contains(t, stdout, "Created credential 'Slack Reports' (ID: c2)")
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-alerts", "retries": float64(3)}, server.data()["Slack Alerts"])
+
+	state, err := os.ReadFile(filepath.Join(dir, ".n8n-credentials-state.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(state), "Slack Alerts:\n            id: c1\n            type: slackApi\n            hash: hmac-sha256:")
+	assert.NotContains(t, string(state), "xoxb")
+
+	// Nothing changed, so only the existence of the credentials is checked
+	server.requests = nil
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--skip-validation")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Credentials: 0 created, 0 updated, 0 deleted, 2 unchanged")
+	assert.ElementsMatch(t, []string{"PATCH /api/v1/credentials/c1", "PATCH /api/v1/credentials/c2"}, server.requests)
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-alerts", "retries": float64(3)}, server.data()["Slack Alerts"], "The data is not sent again")
+
+	// Rotated secret, removed manifest and a credential deleted on the instance
+	t.Setenv("SLACK_TOKEN", "xoxb-rotated")
+	require.NoError(t, os.Remove(filepath.Join(dir, "reports.yml")))
+	writeManifest(t, dir, "errors.json", `{"name": "Slack Errors", "type": "slackApi", "data": {"accessToken": "xoxb-errors"}}`)
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--skip-validation=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Updated credential 'Slack Alerts' (ID: c1)")
+	assert.Contains(t, stdout, "Created credential 'Slack Errors' (ID: c3)")
+	assert.Contains(t, stdout, "Credentials: 1 created, 1 updated, 0 deleted, 0 unchanged")
+	assert.Contains(t, server.data(), "Slack Reports", "Only deleted with --prune")
+	assert.Equal(t, "xoxb-rotated", server.data()["Slack Alerts"].(map[string]interface{})["accessToken"])
+
+	// Deleted on the instance, so created again although its manifest did not change
+	delete(server.credentials, "c3")
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--prune")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Deleted credential 'Slack Reports' (ID: c2) that has no manifest")
+	assert.Contains(t, stdout, "Created credential 'Slack Errors' (ID: c4)")
+	assert.Contains(t, stdout, "Credentials: 1 created, 0 updated, 1 deleted, 1 unchanged")
+	assert.Equal(t, map[string]interface{}{"accessToken": "xoxb-errors"}, server.data()["Slack Errors"])
+
+	// Data changed on the instance is only overwritten with --force
+	server.credentials["c1"]["data"] = map[string]interface{}{"accessToken": "xoxb-edited"}
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--prune=false", "--force")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Updated credential 'Slack Alerts' (ID: c1)")
+	assert.Contains(t, stdout, "Updated credential 'Slack Errors' (ID: c4)")
+	assert.Contains(t, stdout, "Credentials: 0 created, 2 updated, 0 deleted, 0 unchanged")
+	assert.Equal(t, "xoxb-rotated", server.data()["Slack Alerts"].(map[string]interface{})["accessToken"])
+	assert.Equal(t, []string{"Manual", "Slack Alerts", "Slack Errors"}, sortedKeys(server.data()), "Credentials not created by sync are kept")
+}
+
+func TestCredentialsSync_InvalidManifests(t *testing.T) {
+	server := newCredentialStoreServer(t)
+
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  string
+		wantOut  string
+	}{
+		{name: "Missing type", manifest: "name: Slack\ndata:\n  accessToken: x\n", wantErr: "has no 'type'"},
+		{name: "Unknown key", manifest: "name: Slack\ntype: slackApi\ndta:\n  accessToken: x\n", wantErr: "field dta not found"},
+		{name: "Unresolved reference", manifest: "name: Slack\ntype: slackApi\ndata:\n  accessToken: env:MISSING_SLACK_TOKEN\n", wantErr: "environment variable MISSING_SLACK_TOKEN is not set"},
+		{name: "Schema mismatch", manifest: "name: Slack\ntype: slackApi\ndata:\n  acessToken: x\n", wantErr: "1 credential manifest(s) do not match the schema of their type", wantOut: "accessToken: is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeManifest(t, dir, "slack.yaml", tt.manifest)
+			server.requests = nil
+
+			_, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "sync", "-d", dir, "--dry-run=false", "--prune=false", "--skip-validation=false")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Contains(t, stderr, tt.wantOut)
+			for _, request := range server.requests {
+				assert.NotContains(t, request, "POST", "Nothing is created")
+			}
+		})
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,71 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	_ "github.com/edenreich/n8n-cli/cmd/credentials"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialsUpdate(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v1/credentials/42" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Credential not found"}`))
+			return
+		}
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+
+		name, _ := body["name"].(string)
+		if name == "" {
+			name = "GitHub"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "42", "name": name, "type": "githubApi"})
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "credentials", "update", "42", "--data", `{"accessToken": "new-token"}`, "--replace=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Credential updated successfully")
+	assert.Contains(t, stdout, "ID: 42")
+
+	dataFile := filepath.Join(t.TempDir(), "github.json")
+	require.NoError(t, os.WriteFile(dataFile, []byte(`{"user": "bot", "accessToken": "token"}`), 0600))
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "update", "42", "--name", "GitHub (bot)", "--data=", "--data-file", dataFile, "--replace", "-o", "json")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"name": "GitHub (bot)"`)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "update", "42", "--name", "GitHub", "--data-file=", "--replace=false", "-o", "text")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Name: GitHub")
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, map[string]interface{}{"data": map[string]interface{}{"accessToken": "new-token"}, "isPartialData": true}, requests[0])
+	assert.Equal(t, map[string]interface{}{"name": "GitHub (bot)", "data": map[string]interface{}{"user": "bot", "accessToken": "token"}}, requests[1])
+	assert.Equal(t, map[string]interface{}{"name": "GitHub"}, requests[2], "Only the name is changed")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "update", "7", "--name", "Other")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "credentials", "update", "42", "--name=")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing to update")
+}
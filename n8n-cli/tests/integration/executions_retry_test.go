@@ -0,0 +1,204 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	_ "github.com/edenreich/n8n-cli/cmd/executions"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// retryServer is an in-memory n8n executions API serving two executions per page
+type retryServer struct {
+	mu           sync.Mutex
+	executions   []map[string]interface{}
+	retried      []string
+	loadWorkflow []bool
+	inFlight     int
+	maxInFlight  int
+	nextID       int
+}
+
+func newRetryServer(t *testing.T) *retryServer {
+	now := time.Now()
+	state := &retryServer{nextID: 200}
+	for _, execution := range []struct {
+		id             int
+		age            time.Duration
+		retrySuccessID interface{}
+	}{
+		{id: 110, age: 10 * time.Minute},
+		{id: 109, age: 30 * time.Minute, retrySuccessID: "111"},
+		{id: 108, age: time.Hour},
+		{id: 107, age: 3 * time.Hour},
+	} {
+		state.executions = append(state.executions, map[string]interface{}{
+			"id":             strconv.Itoa(execution.id),
+			"workflowId":     "7",
+			"finished":       false,
+			"mode":           "trigger",
+			"startedAt":      now.Add(-execution.age).Format(time.RFC3339),
+			"retryOf":        nil,
+			"retrySuccessId": execution.retrySuccessID,
+		})
+	}
+
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func (s *retryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/executions":
+		if r.URL.Query().Get("workflowId") != "7" || r.URL.Query().Get("status") != "error" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{}, "nextCursor": nil})
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		index, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		end := min(index+2, len(s.executions))
+		page := map[string]interface{}{"data": s.executions[index:end], "nextCursor": nil}
+		if end < len(s.executions) {
+			page["nextCursor"] = strconv.Itoa(end)
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/executions/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/executions/")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, execution := range s.executions {
+			if execution["id"] == id {
+				_ = json.NewEncoder(w).Encode(execution)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/retry"):
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/executions/"), "/retry")
+		var body struct {
+			LoadWorkflow bool `json:"loadWorkflow"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		if id == "404" {
+			s.mu.Unlock()
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		s.inFlight++
+		s.maxInFlight = max(s.maxInFlight, s.inFlight)
+		s.mu.Unlock()
+
+		// Hold the request so that concurrent retries overlap
+		time.Sleep(20 * time.Millisecond)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.inFlight--
+		s.retried = append(s.retried, id)
+		s.loadWorkflow = append(s.loadWorkflow, body.LoadWorkflow)
+		s.nextID++
+		_, _ = fmt.Fprintf(w, `{"id": %d, "workflowId": "7", "finished": true, "retryOf": "%s"}`, s.nextID, id)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestExecutionsRetry_Bulk(t *testing.T) {
+	server := newRetryServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "--workflow", "7", "--status", "error", "--since", "2h",
+		"--load-workflow", "--concurrency", "1", "--dry-run=false", "-o", "json")
+	require.NoError(t, err)
+
+	var results []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &results))
+	require.Len(t, results, 3, "Execution 107 started before --since")
+	assert.Equal(t, "110", results[0]["executionId"])
+	assert.Equal(t, "retried", results[0]["status"])
+	assert.Equal(t, "skipped, already retried as 111", results[1]["status"])
+	assert.Nil(t, results[1]["newExecutionId"])
+	assert.Equal(t, "108", results[2]["executionId"])
+	assert.ElementsMatch(t, []interface{}{"201", "202"}, []interface{}{results[0]["newExecutionId"], results[2]["newExecutionId"]})
+
+	assert.ElementsMatch(t, []string{"110", "108"}, server.retried)
+	assert.Equal(t, []bool{true, true}, server.loadWorkflow)
+	assert.Equal(t, 1, server.maxInFlight)
+}
+
+func TestExecutionsRetry_BulkConcurrency(t *testing.T) {
+	server := newRetryServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "--workflow", "7", "--status", "error", "--since", "0",
+		"--load-workflow=false", "--concurrency", "2", "--dry-run=false", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `OLD_ID\s+NEW_ID\s+WORKFLOW_ID\s+STATUS`, stdout)
+	assert.Regexp(t, `107\s+20\d\s+7\s+retried`, stdout)
+	assert.Contains(t, stdout, "Retried 3 of 4 execution(s)")
+
+	assert.ElementsMatch(t, []string{"110", "108", "107"}, server.retried)
+	assert.Equal(t, []bool{false, false, false}, server.loadWorkflow)
+	assert.Equal(t, 2, server.maxInFlight)
+}
+
+func TestExecutionsRetry_DryRun(t *testing.T) {
+	server := newRetryServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "--workflow", "7", "--status", "error", "--since", "2h",
+		"--load-workflow=false", "--concurrency", "4", "--dry-run", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `110\s+N/A\s+7\s+would be retried`, stdout)
+	assert.Contains(t, stdout, "2 of 3 execution(s) would be retried")
+	assert.Empty(t, server.retried)
+}
+
+func TestExecutionsRetry_Single(t *testing.T) {
+	server := newRetryServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "108", "--workflow=", "--since", "0",
+		"--load-workflow", "--dry-run=false", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `108\s+201\s+7\s+retried`, stdout)
+	assert.Equal(t, []string{"108"}, server.retried)
+
+	_, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "404", "--workflow=", "--since", "0",
+		"--load-workflow=false", "--dry-run=false", "-o", "table")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+	assert.Contains(t, stderr, "Error retrying executions")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "108", "--workflow", "7", "--since", "0",
+		"--dry-run=false", "-o", "table")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be combined with --workflow")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "executions", "retry", "--workflow=", "--since", "0", "--dry-run=false", "-o", "table")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "an execution ID or --workflow is required")
+}
@@ -0,0 +1,101 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	_ "github.com/edenreich/n8n-cli/cmd/executions"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const failedExecutionJSON = `{
+  "id": "1042",
+  "workflowId": "7",
+  "finished": false,
+  "mode": "webhook",
+  "status": "error",
+  "startedAt": "2025-05-01T10:00:00Z",
+  "stoppedAt": "2025-05-01T10:00:01.5Z",
+  "data": {
+    "resultData": {
+      "lastNodeExecuted": "HTTP Request",
+      "runData": {
+        "Webhook": [{
+          "startTime": 1746093600000, "executionTime": 3, "executionStatus": "success", "source": [],
+          "data": {"main": [[{"json": {"email": "jane@example.com"}}, {"json": {"email": "john@example.com"}}]]}
+        }],
+        "HTTP Request": [{
+          "startTime": 1746093600010, "executionTime": 1250, "executionStatus": "error",
+          "source": [{"previousNode": "Webhook"}],
+          "error": {"message": "Request failed with status code 500", "stack": "NodeApiError: Request failed\n    at HttpRequest.execute"}
+        }]
+      }
+    }
+  }
+}`
+
+func newExecutionServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/executions/1042" && r.URL.Query().Get("includeData") == "true" {
+			_, _ = w.Write([]byte(failedExecutionJSON))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+}
+
+func TestExecutionsShow(t *testing.T) {
+	newExecutionServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "show", "1042", "--node=", "--raw=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Execution 1042 of workflow 7")
+	assert.Contains(t, stdout, "Status:   error")
+	assert.Contains(t, stdout, "Duration: 1.5s")
+	assert.Regexp(t, `NODE\s+RUN\s+STARTED\s+DURATION\s+ITEMS_IN\s+ITEMS_OUT\s+STATUS`, stdout)
+	assert.Regexp(t, `Webhook\s+0\s+\S+\s+3ms\s+0\s+2\s+success\n\s*HTTP Request\s+0\s+\S+\s+1\.2s\s+2\s+0\s+error`, stdout)
+	assert.Contains(t, stdout, "Error in node 'HTTP Request' (run 0): Request failed with status code 500")
+	assert.Contains(t, stdout, "  NodeApiError: Request failed\n      at HttpRequest.execute")
+}
+
+func TestExecutionsShow_Node(t *testing.T) {
+	newExecutionServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "show", "1042", "--node", "Webhook", "--raw=false")
+	require.NoError(t, err)
+
+	var outputs []map[string][][]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &outputs))
+	require.Len(t, outputs, 1)
+	assert.Len(t, outputs[0]["main"][0], 2)
+	assert.Equal(t, map[string]interface{}{"email": "jane@example.com"}, outputs[0]["main"][0][0]["json"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "executions", "show", "1042", "--node", "Slack", "--raw=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node 'Slack' did not run in execution 1042")
+}
+
+func TestExecutionsShow_Raw(t *testing.T) {
+	newExecutionServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "executions", "show", "1042", "--node=", "--raw")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"lastNodeExecuted": "HTTP Request"`)
+	assert.Contains(t, stdout, `"status": "error"`)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "executions", "show", "404", "--node=", "--raw=false")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+}
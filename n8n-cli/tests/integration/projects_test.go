@@ -0,0 +1,198 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/projects"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectsServer is an in-memory n8n projects API
+type projectsServer struct {
+	mu       sync.Mutex
+	projects map[string]n8n.Project
+	members  map[string]map[string]string
+	users    map[string]n8n.User
+	nextID   int
+}
+
+func newProjectsServer(t *testing.T) *projectsServer {
+	state := &projectsServer{
+		projects: map[string]n8n.Project{
+			"personal1": {Id: stringPtr("personal1"), Name: "Jane Doe <jane@example.com>", Type: stringPtr("personal")},
+		},
+		members: make(map[string]map[string]string),
+		users: map[string]n8n.User{
+			"user1": {Id: stringPtr("user1"), Email: "jane@example.com", FirstName: stringPtr("Jane"), LastName: stringPtr("Doe")},
+			"user2": {Id: stringPtr("user2"), Email: "john@example.com", FirstName: stringPtr("John")},
+		},
+		nextID: 1,
+	}
+
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func (s *projectsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON := func(status int, value interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(value)
+	}
+
+	if r.URL.Path == "/api/v1/users" && r.Method == http.MethodGet {
+		users := []n8n.User{}
+		for userID := range s.members[r.URL.Query().Get("projectId")] {
+			users = append(users, s.users[userID])
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{"data": users, "nextCursor": nil})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/projects"), "/"), "/")
+	id := parts[0]
+	if id != "" {
+		if _, ok := s.projects[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		ids := make([]string, 0, len(s.projects))
+		for projectID := range s.projects {
+			ids = append(ids, projectID)
+		}
+		sort.Strings(ids)
+		list := []n8n.Project{}
+		for _, projectID := range ids {
+			list = append(list, s.projects[projectID])
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{"data": list, "nextCursor": nil})
+	case r.Method == http.MethodPost && id == "":
+		var body n8n.Project
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		projectID := fmt.Sprintf("project%d", s.nextID)
+		s.nextID++
+		project := n8n.Project{Id: stringPtr(projectID), Name: body.Name, Type: stringPtr("team")}
+		s.projects[projectID] = project
+		writeJSON(http.StatusCreated, project)
+	case r.Method == http.MethodPut && len(parts) == 1:
+		var body n8n.Project
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		project := s.projects[id]
+		project.Name = body.Name
+		s.projects[id] = project
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && len(parts) == 1:
+		delete(s.projects, id)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && len(parts) == 2 && parts[1] == "users":
+		var body struct {
+			Relations []n8n.ProjectRelation `json:"relations"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if s.members[id] == nil {
+			s.members[id] = make(map[string]string)
+		}
+		for _, relation := range body.Relations {
+			s.members[id][relation.UserId] = relation.Role
+		}
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodDelete && len(parts) == 3 && parts[1] == "users":
+		if _, ok := s.members[id][parts[2]]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.members[id], parts[2])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestProjectsCRUD(t *testing.T) {
+	state := newProjectsServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "projects", "create", "Marketing")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Project 'Marketing' (ID: project1) has been created successfully")
+
+	stdout, _, err = executeCommand(t, projects.ListCmd, "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `ID\s+NAME\s+TYPE`, stdout)
+	assert.Regexp(t, `personal1\s+Jane Doe <jane@example.com>\s+personal\n\s*project1\s+Marketing\s+team`, stdout)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "rename", "Marketing", "Growth")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Project 'Marketing' (ID: project1) has been renamed to 'Growth'")
+
+	stdout, _, err = executeCommand(t, projects.ListCmd, "-o", "json")
+	require.NoError(t, err)
+	var list []n8n.Project
+	require.NoError(t, json.Unmarshal([]byte(stdout), &list))
+	require.Len(t, list, 2)
+	assert.Equal(t, "Growth", list[0].Name)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "delete", "project1")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Project 'Growth' (ID: project1) has been deleted successfully")
+	assert.NotContains(t, state.projects, "project1")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "delete", "Growth")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+}
+
+func TestProjectsMembers(t *testing.T) {
+	state := newProjectsServer(t)
+	state.projects["project1"] = n8n.Project{Id: stringPtr("project1"), Name: "Marketing", Type: stringPtr("team")}
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "list", "Marketing", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Project 'Marketing' has no members")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "add", "Marketing", "user1", "user2", "--role", "editor")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Added user1, user2 to project 'Marketing' as project:editor")
+	assert.Equal(t, map[string]string{"user1": "project:editor", "user2": "project:editor"}, state.members["project1"])
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "list", "project1", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `ID\s+EMAIL\s+NAME`, stdout)
+	assert.Regexp(t, `user1\s+jane@example.com\s+Jane Doe\n\s*user2\s+john@example.com\s+John`, stdout)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "remove", "Marketing", "JOHN@example.com")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Removed john@example.com (ID: user2) from project 'Marketing'")
+	assert.Equal(t, map[string]string{"user1": "project:editor"}, state.members["project1"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "remove", "Marketing", "user1", "user2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user 'user2' is not a member of project 'Marketing'")
+	assert.Contains(t, state.members["project1"], "user1", "Nothing is removed when a user is not a member")
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "projects", "members", "add", "Marketing", "user2", "--role", "owner")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid project role 'owner'")
+}
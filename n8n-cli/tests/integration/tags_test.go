@@ -0,0 +1,172 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/tags"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tagsServer is an in-memory n8n tags API serving one tag per page, with
+// workflows that reference the tags
+type tagsServer struct {
+	mu        sync.Mutex
+	tags      map[string]string
+	workflows map[string][]string
+}
+
+func newTagsServer(t *testing.T) *tagsServer {
+	state := &tagsServer{
+		tags: map[string]string{"t1": "production", "t2": "staging", "t3": "unused", "t4": "legacy"},
+		workflows: map[string][]string{
+			"wf1": {"t1"},
+			"wf2": {"t1", "t2"},
+		},
+	}
+
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func (s *tagsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api/v1/tags"), "/")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workflows":
+		workflows := []n8n.Workflow{}
+		for workflowID, tagIDs := range s.workflows {
+			workflowTags := []n8n.Tag{}
+			for _, tagID := range tagIDs {
+				workflowTags = append(workflowTags, n8n.Tag{Id: stringPtr(tagID), Name: s.tags[tagID]})
+			}
+			workflows = append(workflows, n8n.Workflow{Id: stringPtr(workflowID), Name: workflowID, Tags: &workflowTags})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": workflows, "nextCursor": nil})
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tags":
+		ids := make([]string, 0, len(s.tags))
+		for tagID := range s.tags {
+			ids = append(ids, tagID)
+		}
+		sort.Strings(ids)
+
+		// One tag per page to exercise the pagination
+		index := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			index = sort.SearchStrings(ids, cursor)
+		}
+		page := map[string]interface{}{"data": []n8n.Tag{}, "nextCursor": nil}
+		if index < len(ids) {
+			page["data"] = []n8n.Tag{{Id: stringPtr(ids[index]), Name: s.tags[ids[index]]}}
+		}
+		if index+1 < len(ids) {
+			page["nextCursor"] = ids[index+1]
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/v1/tags/"):
+		var body n8n.Tag
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, name := range s.tags {
+			if name == body.Name {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message": "Tag already exists"}`))
+				return
+			}
+		}
+		s.tags[id] = body.Name
+		_ = json.NewEncoder(w).Encode(n8n.Tag{Id: stringPtr(id), Name: body.Name})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/tags/"):
+		name, ok := s.tags[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.tags, id)
+		for workflowID, tagIDs := range s.workflows {
+			kept := []string{}
+			for _, tagID := range tagIDs {
+				if tagID != id {
+					kept = append(kept, tagID)
+				}
+			}
+			s.workflows[workflowID] = kept
+		}
+		_ = json.NewEncoder(w).Encode(n8n.Tag{Id: stringPtr(id), Name: name})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestTagsList(t *testing.T) {
+	newTagsServer(t)
+
+	stdout, _, err := executeCommand(t, tags.ListCmd, "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `ID\s+NAME\s+WORKFLOWS`, stdout)
+	assert.Regexp(t, `t4\s+legacy\s+0\n\s*t1\s+production\s+2\n\s*t2\s+staging\s+1\n\s*t3\s+unused\s+0`, stdout)
+
+	stdout, _, err = executeCommand(t, tags.ListCmd, "-o", "json")
+	require.NoError(t, err)
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &rows))
+	assert.Len(t, rows, 4, "Every page of tags is listed")
+}
+
+func TestTagsRenameDelete(t *testing.T) {
+	server := newTagsServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "tags", "rename", "staging", "stage")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Tag 'staging' (ID: t2) has been renamed to 'stage'")
+	assert.Equal(t, "stage", server.tags["t2"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "tags", "rename", "stage", "production")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrConflict)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "tags", "delete", "t1")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Tag 'production' (ID: t1) has been deleted successfully and removed from 2 workflow(s)")
+	assert.Equal(t, []string{"t2"}, server.workflows["wf2"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "tags", "delete", "missing")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+}
+
+func TestTagsPrune(t *testing.T) {
+	server := newTagsServer(t)
+
+	stdout, _, err := executeCommand(t, tags.PruneCmd, "--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Would delete unused tag 'legacy' (ID: t4)")
+	assert.Contains(t, stdout, "Would delete unused tag 'unused' (ID: t3)")
+	assert.Contains(t, stdout, "Tags: 2 would be deleted, 2 in use")
+	assert.Len(t, server.tags, 4)
+
+	stdout, _, err = executeCommand(t, tags.PruneCmd, "--dry-run=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Tags: 2 deleted, 2 in use")
+	assert.Equal(t, map[string]string{"t1": "production", "t2": "staging"}, server.tags)
+}
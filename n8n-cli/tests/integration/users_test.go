@@ -0,0 +1,218 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/users"
+	"github.com/edenreich/n8n-cli/n8n"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// usersServer is an in-memory n8n users API
+type usersServer struct {
+	mu         sync.Mutex
+	users      map[string]n8n.User
+	nextID     int
+	deletedVia map[string]string
+}
+
+func newUsersServer(t *testing.T) *usersServer {
+	state := &usersServer{
+		users: map[string]n8n.User{
+			"user1": {Id: stringPtr("user1"), Email: "owner@example.com", Role: stringPtr("global:owner")},
+			"user2": {Id: stringPtr("user2"), Email: "jane@example.com", FirstName: stringPtr("Jane"), Role: stringPtr("global:member")},
+			"user3": {Id: stringPtr("user3"), Email: "invited@example.com", Role: stringPtr("global:member"), IsPending: boolPtr(true)},
+		},
+		nextID:     4,
+		deletedVia: make(map[string]string),
+	}
+
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func (s *usersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON := func(value interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(value)
+	}
+
+	if r.URL.Path == "/api/v1/projects" {
+		writeJSON(map[string]interface{}{"data": []n8n.Project{
+			{Id: stringPtr("team1"), Name: "Marketing", Type: stringPtr("team")},
+		}})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/users"), "/"), "/")
+	id := parts[0]
+	if _, ok := s.users[id]; id != "" && !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		ids := make([]string, 0, len(s.users))
+		for userID := range s.users {
+			ids = append(ids, userID)
+		}
+		sort.Strings(ids)
+		list := []n8n.User{}
+		for _, userID := range ids {
+			list = append(list, s.users[userID])
+		}
+		writeJSON(map[string]interface{}{"data": list, "nextCursor": nil})
+	case r.Method == http.MethodPost && id == "":
+		var invites []n8n.UserInvite
+		_ = json.NewDecoder(r.Body).Decode(&invites)
+		results := []map[string]interface{}{}
+		for _, invite := range invites {
+			if strings.HasSuffix(invite.Email, "@blocked.example.com") {
+				results = append(results, map[string]interface{}{"user": map[string]interface{}{"email": invite.Email}, "error": "domain is blocked"})
+				continue
+			}
+			userID := fmt.Sprintf("user%d", s.nextID)
+			s.nextID++
+			s.users[userID] = n8n.User{Id: stringPtr(userID), Email: openapi_types.Email(invite.Email), Role: stringPtr(invite.Role), IsPending: boolPtr(true)}
+			results = append(results, map[string]interface{}{"user": map[string]interface{}{
+				"id": userID, "email": invite.Email, "inviteAcceptUrl": "https://n8n.example.com/signup?inviteeId=" + userID, "emailSent": false,
+			}})
+		}
+		writeJSON(results)
+	case r.Method == http.MethodPatch && len(parts) == 2 && parts[1] == "role":
+		var body struct {
+			NewRoleName string `json:"newRoleName"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		user := s.users[id]
+		user.Role = &body.NewRoleName
+		s.users[id] = user
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete && len(parts) == 1:
+		delete(s.users, id)
+		s.deletedVia[id] = r.URL.Query().Get("transferId")
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestUsersList(t *testing.T) {
+	newUsersServer(t)
+
+	stdout, _, err := executeCommand(t, users.ListCmd, "-o", "table", "--pending=false")
+	require.NoError(t, err)
+	assert.Regexp(t, `ID\s+EMAIL\s+NAME\s+ROLE\s+STATUS`, stdout)
+	assert.Regexp(t, `user3\s+invited@example.com\s+global:member\s+pending\n\s*user2\s+jane@example.com\s+Jane\s+global:member\s+active`, stdout)
+
+	stdout, _, err = executeCommand(t, users.ListCmd, "-o", "json", "--pending")
+	require.NoError(t, err)
+	var list []n8n.User
+	require.NoError(t, json.Unmarshal([]byte(stdout), &list))
+	require.Len(t, list, 1)
+	assert.Equal(t, "invited@example.com", string(list[0].Email))
+}
+
+func TestUsersInvite(t *testing.T) {
+	state := newUsersServer(t)
+
+	file := filepath.Join(t.TempDir(), "users.csv")
+	require.NoError(t, os.WriteFile(file, []byte("email,role\nnew@example.com,admin\nJane@example.com\nbad@blocked.example.com,member\n"), 0600))
+
+	stdout, _, err := executeCommand(t, users.InviteCmd, "--file", file, "--email", "other@example.com", "--dry-run", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `N/A\s+other@example.com\s+global:member\s+would invite`, stdout)
+	assert.Regexp(t, `N/A\s+new@example.com\s+global:admin\s+would invite`, stdout)
+	assert.Regexp(t, `user2\s+Jane@example.com\s+global:member\s+exists`, stdout)
+	assert.Len(t, state.users, 3, "Dry run does not invite anyone")
+
+	// Slice flags keep their values between executions
+	resetEmails := func() {
+		require.NoError(t, users.InviteCmd.Flags().Lookup("email").Value.(pflag.SliceValue).Replace(nil))
+	}
+	resetEmails()
+
+	stdout, stderr, err := executeCommand(t, users.InviteCmd, "--file", file, "--dry-run=false", "-o", "json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to invite 1 user(s)")
+	assert.Contains(t, stderr, "Error inviting bad@blocked.example.com: domain is blocked")
+
+	var results []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &results))
+	require.Len(t, results, 3)
+	assert.Equal(t, "invited", results[0]["status"])
+	assert.Equal(t, "https://n8n.example.com/signup?inviteeId=user4", results[0]["inviteAcceptUrl"])
+	assert.Equal(t, "exists", results[1]["status"])
+	assert.Equal(t, "failed", results[2]["status"])
+	assert.Equal(t, "global:admin", *state.users["user4"].Role)
+
+	resetEmails()
+	_, _, err = executeCommand(t, users.InviteCmd, "--file", "", "--email", "not-an-email")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid email address 'not-an-email'")
+}
+
+func TestUsersSetRoleAndDelete(t *testing.T) {
+	state := newUsersServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "users", "set-role", "jane@example.com", "admin", "--dry-run", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `user2\s+jane@example.com\s+global:admin\s+would change from global:member`, stdout)
+	assert.Equal(t, "global:member", *state.users["user2"].Role)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "users", "set-role", "user2", "global:admin", "--dry-run=false", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "changed from global:member")
+	assert.Equal(t, "global:admin", *state.users["user2"].Role)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "users", "set-role", "user2", "owner")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid role 'owner'")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "users", "delete", "jane@example.com", "--transfer-to", "Marketing", "--dry-run", "-o", "yaml")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "status: would be deleted, data moved to 'Marketing'")
+	assert.Contains(t, stdout, "dryRun: true")
+	assert.Contains(t, state.users, "user2")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "users", "delete", "jane@example.com", "--transfer-to", "team1", "--dry-run=false", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `user2\s+jane@example.com\s+global:admin\s+deleted, data moved to 'Marketing'`, stdout)
+	assert.Equal(t, map[string]string{"user2": "team1"}, state.deletedVia)
+
+	_, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "users", "delete", "invited@example.com", "--transfer-to", "", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "Warning: the workflows and credentials of invited@example.com are deleted with the user")
+	assert.Equal(t, "", state.deletedVia["user3"])
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "users", "delete", "missing@example.com")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+}
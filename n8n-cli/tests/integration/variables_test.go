@@ -0,0 +1,211 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/variables"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// variablesServer is an in-memory n8n variables API serving one variable per page
+type variablesServer struct {
+	mu        sync.Mutex
+	variables map[string]n8n.Variable
+	nextID    int
+}
+
+func newVariablesServer(t *testing.T, initial map[string]string) *httptest.Server {
+	state := &variablesServer{variables: make(map[string]n8n.Variable), nextID: 1}
+	keys := make([]string, 0, len(initial))
+	for key := range initial {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		state.add(key, initial[key])
+	}
+
+	server := httptest.NewServer(state)
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return server
+}
+
+func (s *variablesServer) add(key, value string) {
+	id := fmt.Sprintf("var%d", s.nextID)
+	s.nextID++
+	s.variables[id] = n8n.Variable{Id: stringPtr(id), Key: key, Value: value}
+}
+
+func (s *variablesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api/v1/variables"), "/")
+	var body n8n.Variable
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		ids := make([]string, 0, len(s.variables))
+		for variableID := range s.variables {
+			ids = append(ids, variableID)
+		}
+		sort.Strings(ids)
+
+		// One variable per page to exercise the pagination
+		index := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			index = sort.SearchStrings(ids, cursor)
+		}
+		page := map[string]interface{}{"data": []n8n.Variable{}, "nextCursor": nil}
+		if index < len(ids) {
+			page["data"] = []n8n.Variable{s.variables[ids[index]]}
+		}
+		if index+1 < len(ids) {
+			page["nextCursor"] = ids[index+1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	case r.Method == http.MethodPost && id == "":
+		s.add(body.Key, body.Value)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		if _, ok := s.variables[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.variables[id] = n8n.Variable{Id: stringPtr(id), Key: body.Key, Value: body.Value}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		if _, ok := s.variables[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.variables, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// remoteVariables returns the variables of the server by key
+func remoteVariables(t *testing.T) map[string]string {
+	stdout, _, err := executeCommand(t, variables.ListCmd, "-o", "json")
+	require.NoError(t, err)
+
+	var list []n8n.Variable
+	require.NoError(t, json.Unmarshal([]byte(stdout), &list))
+
+	values := make(map[string]string)
+	for _, variable := range list {
+		values[variable.Key] = variable.Value
+	}
+	return values
+}
+
+func TestVariablesCRUD(t *testing.T) {
+	newVariablesServer(t, map[string]string{"EXISTING": "one"})
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "variables", "create", "API_URL", "https://api.example.com")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Variable 'API_URL' has been created successfully")
+
+	stdout, _, err = executeCommand(t, variables.ListCmd, "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `ID\s+KEY\s+VALUE`, stdout)
+	assert.Regexp(t, `var2\s+API_URL\s+https://api.example.com\n\s*var1\s+EXISTING\s+one`, stdout)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "variables", "update", "EXISTING", "two", "--key", "RENAMED")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Variable 'RENAMED' (ID: var1) has been updated successfully")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "variables", "delete", "var2")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Variable 'API_URL' (ID: var2) has been deleted successfully")
+
+	assert.Equal(t, map[string]string{"RENAMED": "two"}, remoteVariables(t))
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "variables", "delete", "MISSING")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "variables", "create", "not-valid", "x")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only letters, digits and underscores are allowed")
+}
+
+func TestVariablesSync(t *testing.T) {
+	newVariablesServer(t, map[string]string{"KEEP": "same", "CHANGE": "old", "STALE": "gone"})
+
+	file := filepath.Join(t.TempDir(), "variables.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("variables:\n  KEEP: same\n  CHANGE: new\n  ADD: 3.0\n"), 0600))
+
+	stdout, _, err := executeCommand(t, variables.SyncCmd, "-f", file, "--prune", "--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Would update variable 'CHANGE' (ID: var1)")
+	assert.Contains(t, stdout, "Would create variable 'ADD'")
+	assert.Contains(t, stdout, "Would delete variable 'STALE' (ID: var3) that is not in the file")
+	assert.Contains(t, stdout, "Variables: 1 would be created, 1 would be updated, 1 would be deleted, 1 unchanged")
+	assert.NotContains(t, stdout, "new", "Values are never printed")
+	assert.Equal(t, map[string]string{"KEEP": "same", "CHANGE": "old", "STALE": "gone"}, remoteVariables(t))
+
+	stdout, _, err = executeCommand(t, variables.SyncCmd, "-f", file, "--prune=false", "--dry-run=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Variables: 1 created, 1 updated, 0 deleted, 1 unchanged")
+	assert.Equal(t, map[string]string{"KEEP": "same", "CHANGE": "new", "ADD": "3.0", "STALE": "gone"}, remoteVariables(t))
+
+	stdout, _, err = executeCommand(t, variables.SyncCmd, "-f", file, "--prune")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Deleted variable 'STALE' (ID: var3) that is not in the file")
+	assert.Contains(t, stdout, "Variables: 0 created, 0 updated, 1 deleted, 3 unchanged")
+	assert.Equal(t, map[string]string{"KEEP": "same", "CHANGE": "new", "ADD": "3.0"}, remoteVariables(t))
+}
+
+func TestVariablesSync_InvalidFile(t *testing.T) {
+	newVariablesServer(t, nil)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "Missing section", content: "API_URL: x\n", wantErr: "has no 'variables' section"},
+		{name: "Invalid key", content: "variables:\n  api-url: x\n", wantErr: "line 2: invalid variable key 'api-url'"},
+		{name: "Duplicate key", content: "variables:\n  A: x\n  A: y\n", wantErr: "line 3: variable 'A' is defined more than once"},
+		{name: "Nested value", content: "variables:\n  A:\n    b: c\n", wantErr: "variable 'A' must have a string value"},
+		{name: "Null value", content: "variables:\n  A:\n", wantErr: "variable 'A' must have a string value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "variables.yaml")
+			require.NoError(t, os.WriteFile(file, []byte(tt.content), 0600))
+
+			_, _, err := executeCommand(t, variables.SyncCmd, "-f", file, "--prune=false", "--dry-run=false")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -85,7 +86,7 @@ func TestCreateWorkflow(t *testing.T) {
 			viper.Set("instance_url", mockServer.URL)
 
 			client := n8n.NewClient(mockServer.URL, "test-api-key")
-			result, err := client.CreateWorkflow(&tc.workflow)
+			result, err := client.CreateWorkflow(context.Background(), &tc.workflow)
 
 			if tc.expectedError {
 				assert.Error(t, err)
@@ -145,7 +146,7 @@ func TestWorkflowSyncBug14(t *testing.T) {
 	defer mockServer.Close()
 
 	client := n8n.NewClient(mockServer.URL, "test-api-key")
-	result, err := client.CreateWorkflow(&testWorkflow)
+	result, err := client.CreateWorkflow(context.Background(), &testWorkflow)
 
 	assert.NoError(t, err, "Creating workflow should not error with ID and active fields")
 	assert.NotNil(t, result)
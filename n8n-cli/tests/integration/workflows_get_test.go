@@ -2,6 +2,8 @@
 package integration
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -96,11 +98,17 @@ func TestGetWorkflow(t *testing.T) {
 			defer cleanup()
 
 			client := n8n.NewClient(server.URL, "test-api-key")
-			workflow, err := client.GetWorkflow(tc.workflowID)
+			workflow, err := client.GetWorkflow(context.Background(), tc.workflowID)
 
 			if tc.expectedError {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.errorContains)
+				assert.True(t, errors.Is(err, n8n.ErrNotFound), "Expected a not found API error")
+
+				var apiErr *n8n.APIError
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, "Workflow not found", apiErr.Message)
+				assert.Equal(t, "/api/v1/workflows/"+tc.workflowID, apiErr.Path)
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, workflow)
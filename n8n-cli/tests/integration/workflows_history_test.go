@@ -0,0 +1,120 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/cmd/workflows"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const currentWorkflowJSON = `{
+  "id": "wf1",
+  "name": "Lead Intake",
+  "active": true,
+  "versionId": "v3",
+  "activeVersion": {"versionId": "v2"},
+  "nodes": [
+    {"name": "Webhook", "type": "n8n-nodes-base.webhook", "parameters": {"path": "leads"}},
+    {"name": "Set", "type": "n8n-nodes-base.set", "parameters": {"value": "broken"}}
+  ],
+  "connections": {"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}},
+  "settings": {"executionOrder": "v1"}
+}`
+
+const versionJSON = `{
+  "versionId": "v2",
+  "workflowId": "wf1",
+  "name": "Lead Intake",
+  "authors": "Jane Doe",
+  "createdAt": "2025-05-01T10:00:00Z",
+  "nodes": [
+    {"name": "Webhook", "type": "n8n-nodes-base.webhook", "parameters": {"path": "leads"}},
+    {"name": "Set", "type": "n8n-nodes-base.set", "parameters": {"value": "working"}},
+    {"name": "Slack", "type": "n8n-nodes-base.slack", "parameters": {}}
+  ],
+  "connections": {"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}}
+}`
+
+func newHistoryServer(t *testing.T) *n8n.Workflow {
+	var mu sync.Mutex
+	updated := &n8n.Workflow{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workflows/wf1":
+			_, _ = w.Write([]byte(currentWorkflowJSON))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workflows/wf1/v2":
+			_, _ = w.Write([]byte(versionJSON))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/workflows/wf1/"):
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/workflows/wf1":
+			_ = json.NewDecoder(r.Body).Decode(updated)
+			_, _ = w.Write([]byte(currentWorkflowJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return updated
+}
+
+func TestWorkflowsHistory_List(t *testing.T) {
+	newHistoryServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "history", "wf1", "-o", "table")
+	require.NoError(t, err)
+	assert.Regexp(t, `VERSION_ID\s+CREATED\s+AUTHORS\s+STATUS`, stdout)
+	assert.Regexp(t, `v3\s+N/A\s+current\n`, stdout)
+	assert.Regexp(t, `v2\s+2025-05-01T10:00:00Z\s+Jane Doe\s+published`, stdout)
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "history", "show", "wf1", "v2", "-o", "yaml")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "versionId: v2")
+	assert.Contains(t, stdout, "value: working")
+}
+
+func TestWorkflowsHistory_Restore(t *testing.T) {
+	updated := newHistoryServer(t)
+	require.NotNil(t, workflows.HistoryRestoreCmd)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "history", "restore", "wf1", "v2", "--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Restoring version v2 changes workflow 'Lead Intake' (ID: wf1):")
+	assert.Contains(t, stdout, "~ node 'Set' (n8n-nodes-base.set)\n"+
+		`    - "value": "broken"`+"\n"+
+		`    + "value": "working"`)
+	assert.Contains(t, stdout, "+ node 'Slack' (n8n-nodes-base.slack)")
+	assert.NotContains(t, stdout, "node 'Webhook'")
+	assert.Empty(t, updated.Nodes, "Dry run does not update the workflow")
+
+	rootcmd.GetRootCmd().SetIn(strings.NewReader(""))
+	t.Cleanup(func() { rootcmd.GetRootCmd().SetIn(nil) })
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "history", "restore", "wf1", "v2", "--dry-run=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use --yes to restore without confirmation")
+
+	stdout, _, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "history", "restore", "wf1", "v2", "--dry-run=false", "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Workflow 'Lead Intake' (ID: wf1) has been restored to version v2")
+	assert.Contains(t, stdout, "To undo, run: n8n workflows history restore wf1 v3")
+	require.Len(t, updated.Nodes, 3)
+	assert.Equal(t, "working", (*updated.Nodes[1].Parameters)["value"])
+	assert.Equal(t, "v1", *updated.Settings.ExecutionOrder, "Settings are kept")
+}
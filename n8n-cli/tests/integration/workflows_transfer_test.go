@@ -0,0 +1,168 @@
+// Package integration contains integration tests for the n8n-cli
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	rootcmd "github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferWorkflowsJSON = `{"data": [
+  {
+    "id": "wf1", "name": "Lead Intake", "nodes": [
+      {"name": "Slack", "type": "n8n-nodes-base.slack", "credentials": {"slackApi": {"id": "c1", "name": "Slack Bot"}}},
+      {"name": "Sheets", "type": "n8n-nodes-base.googleSheets", "credentials": {"googleSheetsOAuth2Api": {"id": "c2", "name": "Google"}}}
+    ],
+    "connections": {}, "settings": {},
+    "tags": [{"id": "t1", "name": "marketing"}],
+    "shared": [{"role": "workflow:owner", "projectId": "personal"}]
+  },
+  {
+    "id": "wf2", "name": "Newsletter", "nodes": [
+      {"name": "Slack", "type": "n8n-nodes-base.slack", "credentials": {"slackApi": {"id": "c1", "name": "Slack Bot"}}},
+      {"name": "Mailchimp", "type": "n8n-nodes-base.mailchimp", "credentials": {"mailchimpApi": {"id": "c3", "name": "Mailchimp"}}}
+    ],
+    "connections": {}, "settings": {},
+    "tags": [{"id": "t1", "name": "marketing"}],
+    "shared": [{"role": "workflow:owner", "projectId": "p1"}]
+  },
+  {
+    "id": "wf3", "name": "Invoices", "nodes": [
+      {"name": "Stripe", "type": "n8n-nodes-base.stripe", "credentials": {"stripeApi": {"id": "c4", "name": "Stripe"}}}
+    ],
+    "connections": {}, "settings": {},
+    "tags": [{"id": "t2", "name": "finance"}],
+    "shared": [{"role": "workflow:owner", "projectId": "personal"}]
+  }
+], "nextCursor": null}`
+
+// transferServer records the workflows and credentials transferred to each project
+type transferServer struct {
+	mu          sync.Mutex
+	workflows   map[string]string
+	credentials map[string]string
+}
+
+func newTransferServer(t *testing.T) *transferServer {
+	state := &transferServer{workflows: map[string]string{}, credentials: map[string]string{}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.mu.Lock()
+		defer state.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workflows":
+			_, _ = w.Write([]byte(transferWorkflowsJSON))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects":
+			_ = json.NewEncoder(w).Encode(n8n.ProjectList{Data: &[]n8n.Project{
+				{Id: stringPtr("personal"), Name: "Jane Doe <jane@example.com>"},
+				{Id: stringPtr("p1"), Name: "Marketing"},
+			}})
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/transfer"):
+			var body struct {
+				DestinationProjectID string `json:"destinationProjectId"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			path := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/transfer")
+			kind, id, _ := strings.Cut(path, "/")
+			if id == "c2" || id == "wf3" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message": "You are not allowed to transfer this resource"}`))
+				return
+			}
+			if kind == "workflows" {
+				state.workflows[id] = body.DestinationProjectID
+			} else {
+				state.credentials[id] = body.DestinationProjectID
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupTestConfig(t, server.URL, "test-api-key")
+	t.Cleanup(teardownTestConfig)
+	t.Chdir(t.TempDir())
+
+	return state
+}
+
+func TestWorkflowsTransfer_Single(t *testing.T) {
+	server := newTransferServer(t)
+
+	stdout, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "Lead Intake", "--project", "Marketing",
+		"--tag=", "--with-credentials=false", "--dry-run=false")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Transferred workflow 'Lead Intake' (ID: wf1) to project 'Marketing'")
+	assert.Contains(t, stdout, "Workflows: 1 transferred, 0 already in the project")
+	assert.Contains(t, stderr, "use --with-credentials to transfer them as well: 'Google' (ID: c2), 'Slack Bot' (ID: c1)")
+	assert.Equal(t, map[string]string{"wf1": "p1"}, server.workflows)
+	assert.Empty(t, server.credentials)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "Missing", "--project", "Marketing",
+		"--tag=", "--with-credentials=false", "--dry-run=false")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, n8n.ErrNotFound)
+
+	_, _, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "wf1", "--project", "Marketing",
+		"--tag", "marketing", "--with-credentials=false", "--dry-run=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "either a workflow or --tag is required")
+}
+
+func TestWorkflowsTransfer_ByTag(t *testing.T) {
+	server := newTransferServer(t)
+
+	stdout, _, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "--project", "p1",
+		"--tag", "marketing", "--with-credentials", "--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Would transfer workflow 'Lead Intake' (ID: wf1) to project 'Marketing'")
+	assert.Contains(t, stdout, "Workflow 'Newsletter' (ID: wf2) is already in project 'Marketing'")
+	assert.Contains(t, stdout, "Would transfer credential 'Slack Bot' (ID: c1) to project 'Marketing'")
+	assert.Contains(t, stdout, "Workflows: 1 would be transferred, 1 already in the project; credentials: 2 would be transferred")
+	assert.NotContains(t, stdout, "Invoices")
+	assert.Empty(t, server.workflows)
+
+	stdout, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "--project", "p1",
+		"--tag", "marketing", "--with-credentials", "--dry-run=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to transfer 1 item(s)")
+	assert.Contains(t, stderr, "Error transferring credential 'Google' (ID: c2)")
+	assert.Contains(t, stdout, "Workflows: 1 transferred, 1 already in the project; credentials: 1 transferred")
+	assert.Equal(t, map[string]string{"wf1": "p1"}, server.workflows)
+	assert.Equal(t, map[string]string{"c1": "p1"}, server.credentials)
+}
+
+func TestWorkflowsTransfer_CredentialsOfTransferredWorkflowsOnly(t *testing.T) {
+	server := newTransferServer(t)
+
+	stdout, stderr, err := executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "--project", "p1",
+		"--tag", "marketing", "--with-credentials=false", "--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Workflows: 1 would be transferred, 1 already in the project")
+	assert.Contains(t, stderr, "use --with-credentials to transfer them as well: 'Google' (ID: c2), 'Slack Bot' (ID: c1)")
+	assert.NotContains(t, stderr, "Mailchimp", "The credentials of a workflow already in the project stay where they are")
+
+	stdout, stderr, err = executeCommand(t, rootcmd.GetRootCmd(), "workflows", "transfer", "--project", "p1",
+		"--tag", "finance", "--with-credentials", "--dry-run=false")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to transfer 1 item(s)")
+	assert.Contains(t, stderr, "Error transferring workflow 'Invoices' (ID: wf3)")
+	assert.Contains(t, stdout, "Workflows: 0 transferred, 0 already in the project; credentials: 0 transferred")
+	assert.NotContains(t, stdout, "Stripe", "The credentials of a workflow that failed to transfer stay where they are")
+	assert.Empty(t, server.workflows)
+	assert.Empty(t, server.credentials)
+}
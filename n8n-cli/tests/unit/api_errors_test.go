@@ -0,0 +1,65 @@
+// Package unit contains unit tests for the n8n-cli
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		target     error
+		expected   bool
+	}{
+		{"Not found matches ErrNotFound", http.StatusNotFound, n8n.ErrNotFound, true},
+		{"Unauthorized matches ErrUnauthorized", http.StatusUnauthorized, n8n.ErrUnauthorized, true},
+		{"Conflict matches ErrConflict", http.StatusConflict, n8n.ErrConflict, true},
+		{"Server error does not match ErrNotFound", http.StatusInternalServerError, n8n.ErrNotFound, false},
+		{"Not found does not match ErrConflict", http.StatusNotFound, n8n.ErrConflict, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &n8n.APIError{StatusCode: tc.statusCode})
+			assert.Equal(t, tc.expected, errors.Is(err, tc.target))
+		})
+	}
+}
+
+func TestAPIError_ErrorMessage(t *testing.T) {
+	withMessage := &n8n.APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     http.MethodGet,
+		Path:       "/api/v1/workflows/123",
+		Message:    "Not Found",
+		Body:       []byte(`{"message": "Not Found"}`),
+	}
+	assert.Equal(t, "API returned error 404 for GET /api/v1/workflows/123: Not Found", withMessage.Error())
+
+	rawBody := &n8n.APIError{
+		StatusCode: http.StatusBadGateway,
+		Method:     http.MethodPut,
+		Path:       "/api/v1/workflows/123",
+		Body:       []byte("<html>Bad Gateway</html>\n"),
+	}
+	assert.Equal(t, "API returned error 502 for PUT /api/v1/workflows/123: <html>Bad Gateway</html>", rawBody.Error())
+
+	var apiErr *n8n.APIError
+	assert.True(t, errors.As(fmt.Errorf("wrapped: %w", rawBody), &apiErr))
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+}
+
+func TestFindWorkflow_NotFoundMatchesSentinel(t *testing.T) {
+	_, err := cmd.FindWorkflow("Missing", []n8n.Workflow{{Id: stringPtr("1"), Name: "Existing"}})
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, n8n.ErrNotFound))
+	assert.Equal(t, "workflow with name 'Missing' not found", err.Error())
+}
@@ -0,0 +1,59 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/edenreich/n8n-cli/n8n/clientfakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveProject(t *testing.T) {
+	projects := []n8n.Project{
+		{Id: stringPtr("p1"), Name: "Marketing"},
+		{Id: stringPtr("p2"), Name: "p1"},
+		{Id: stringPtr("p3"), Name: "Sales"},
+		{Id: stringPtr("p4"), Name: "Sales"},
+	}
+
+	testCases := []struct {
+		name       string
+		nameOrID   string
+		expectedID string
+		expectErr  string
+	}{
+		{name: "By ID", nameOrID: "p3", expectedID: "p3"},
+		{name: "By name", nameOrID: "Marketing", expectedID: "p1"},
+		{name: "ID before name", nameOrID: "p1", expectedID: "p1"},
+		{name: "Ambiguous name", nameOrID: "Sales", expectErr: "2 projects are named 'Sales', use the project ID instead"},
+		{name: "Not found", nameOrID: "Support", expectErr: "project 'Support' not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &clientfakes.FakeClientInterface{}
+			client.GetProjectsReturns(&n8n.ProjectList{Data: &projects}, nil)
+
+			project, err := cmd.ResolveProject(context.Background(), client, tc.nameOrID)
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedID, *project.Id)
+		})
+	}
+
+	t.Run("Not found matches sentinel", func(t *testing.T) {
+		client := &clientfakes.FakeClientInterface{}
+		client.GetProjectsReturns(&n8n.ProjectList{}, nil)
+
+		_, err := cmd.ResolveProject(context.Background(), client, "Marketing")
+		assert.True(t, errors.Is(err, n8n.ErrNotFound))
+	})
+}
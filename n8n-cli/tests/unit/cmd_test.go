@@ -7,6 +7,7 @@ import (
 
 	"github.com/edenreich/n8n-cli/cmd"
 	"github.com/edenreich/n8n-cli/config"
+	"github.com/edenreich/n8n-cli/n8n"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,7 +37,7 @@ func TestSanitizeFilename(t *testing.T) {
 	}
 }
 
-func TestFormatAPIBaseURL(t *testing.T) {
+func TestNewClientBaseURL(t *testing.T) {
 	testCases := []struct {
 		name            string
 		instanceURL     string
@@ -71,7 +72,7 @@ func TestFormatAPIBaseURL(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := cmd.FormatAPIBaseURL(tc.instanceURL)
+			result := n8n.NewClient(tc.instanceURL, "test-api-key").BaseURL()
 			assert.Equal(t, tc.expectedBaseURL, result, "Expected correctly formatted API base URL")
 		})
 	}
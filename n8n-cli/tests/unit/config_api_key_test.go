@@ -0,0 +1,159 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edenreich/n8n-cli/cmd"
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// envFileReader serves a fixed .env content
+type envFileReader struct {
+	content string
+}
+
+func (r *envFileReader) Open(name string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(r.content)), nil
+}
+
+// writeHelperScript writes a fake credential helper that records its runs and prints output
+func writeHelperScript(t *testing.T, output string) (string, func() int) {
+	dir := t.TempDir()
+	runsFile := filepath.Join(dir, "runs")
+	script := filepath.Join(dir, "helper.sh")
+
+	content := fmt.Sprintf("#!/bin/sh\necho run >> %s\nprintf '%s'\n", runsFile, output)
+	require.NoError(t, os.WriteFile(script, []byte(content), 0700))
+
+	return script, func() int {
+		data, err := os.ReadFile(runsFile)
+		if err != nil {
+			return 0
+		}
+		return strings.Count(string(data), "run")
+	}
+}
+
+func TestResolveAPIKey_Precedence(t *testing.T) {
+	helper, helperRuns := writeHelperScript(t, "helper-key")
+
+	tests := []struct {
+		name       string
+		flag       string
+		env        string
+		envFile    string
+		configFile string
+		command    string
+		want       string
+		wantRuns   int
+	}{
+		{name: "Flag wins over everything", flag: "flag-key", env: "env-key", envFile: "dotenv-key", configFile: "config-key", command: helper, want: "flag-key"},
+		{name: "Environment wins over .env", env: "env-key", envFile: "dotenv-key", configFile: "config-key", command: helper, want: "env-key"},
+		{name: ".env wins over config file", envFile: "dotenv-key", configFile: "config-key", command: helper, want: "dotenv-key"},
+		{name: "Config file wins over command", configFile: "config-key", command: helper, want: "config-key"},
+		{name: "Command is the last resort", command: helper, want: "helper-key", wantRuns: 1},
+		{name: "No source configured", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runsBefore := helperRuns()
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			t.Setenv("N8N_API_KEY", tt.env)
+
+			v := viper.GetViper()
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("api-key", "", "")
+			require.NoError(t, v.BindPFlag("api_key", flags.Lookup("api-key")))
+			if tt.flag != "" {
+				require.NoError(t, flags.Set("api-key", tt.flag))
+			}
+			require.NoError(t, v.BindEnv("api_key", "N8N_API_KEY"))
+
+			if tt.configFile != "" {
+				v.SetConfigType("yaml")
+				require.NoError(t, v.ReadConfig(strings.NewReader("api_key: "+tt.configFile)))
+			}
+			if tt.envFile != "" {
+				config.LoadEnvFileWithReader(&envFileReader{content: "N8N_API_KEY=" + tt.envFile}, v)
+			}
+			if tt.command != "" {
+				v.Set("api_key_command", tt.command)
+			}
+
+			apiKey, err := config.ResolveAPIKey(context.Background(), v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, apiKey)
+			assert.Equal(t, tt.wantRuns, helperRuns()-runsBefore, "Unexpected number of helper runs")
+		})
+	}
+}
+
+func TestResolveAPIKey_CommandRunsOnce(t *testing.T) {
+	helper, helperRuns := writeHelperScript(t, "helper-key\\nmetadata: ignored\\n")
+
+	v := viper.New()
+	v.Set("api_key_command", helper)
+
+	for i := 0; i < 3; i++ {
+		apiKey, err := config.ResolveAPIKey(context.Background(), v)
+		require.NoError(t, err)
+		assert.Equal(t, "helper-key", apiKey, "Only the first line of the output should be used")
+	}
+
+	assert.Equal(t, 1, helperRuns())
+}
+
+func TestResolveAPIKey_CommandErrors(t *testing.T) {
+	empty, _ := writeHelperScript(t, "")
+
+	tests := []struct {
+		name    string
+		command string
+		wantErr string
+	}{
+		{name: "Command fails", command: "echo 'vault is sealed' >&2; exit 1", wantErr: "vault is sealed"},
+		{name: "Command prints nothing", command: empty, wantErr: "printed no API key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set("api_key_command", tt.command)
+
+			apiKey, err := config.ResolveAPIKey(context.Background(), v)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, apiKey)
+		})
+	}
+}
+
+func TestNewAPIClient_CancelledContextStopsCommand(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("N8N_API_KEY", "")
+	viper.Set("instance_url", "http://localhost:5678")
+	viper.Set("api_key_command", "sleep 10")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	_, err := cmd.NewAPIClient(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "api_key_command failed")
+	assert.Less(t, time.Since(start), 5*time.Second, "The command should not outlive the context")
+}
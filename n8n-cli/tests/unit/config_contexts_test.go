@@ -0,0 +1,185 @@
+package unit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const contextsConfig = `
+instance_url: http://top-level:5678
+api_key: top-level-key
+timeout: 30s
+current-context: dev
+contexts:
+  dev:
+    instance_url: http://dev:5678
+    api_key: dev-key
+  prod:
+    instance_url: https://prod.example.com
+    api_key_command: pass show n8n/prod
+    ca_file: /etc/ssl/corporate-ca.pem
+    auth:
+      type: bearer
+      token: prod-gateway-token
+`
+
+// initializeWithConfig writes content as config.yaml and initializes the configuration
+// from it, with context selected through N8N_CONTEXT
+func initializeWithConfig(t *testing.T, content, context string) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(content), 0600))
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("N8N_API_KEY", "")
+	t.Setenv("N8N_INSTANCE_URL", "")
+	t.Setenv("N8N_CONTEXT", context)
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.AddConfigPath(dir)
+	config.Initialize()
+}
+
+func TestContexts_CurrentContextApplied(t *testing.T) {
+	initializeWithConfig(t, contextsConfig, "")
+
+	assert.Equal(t, "dev", config.ActiveContext(viper.GetViper()))
+	assert.Equal(t, "http://dev:5678", viper.GetString("instance_url"))
+	assert.Equal(t, "dev-key", viper.GetString("api_key"))
+	assert.Equal(t, "30s", viper.GetString("timeout"), "Top-level settings apply to every context")
+	assert.NoError(t, config.ValidateContext(viper.GetViper()))
+}
+
+func TestContexts_SelectedContextOverridesCurrent(t *testing.T) {
+	initializeWithConfig(t, contextsConfig, "prod")
+
+	assert.Equal(t, "prod", config.ActiveContext(viper.GetViper()))
+	assert.Equal(t, "https://prod.example.com", viper.GetString("instance_url"))
+	assert.Empty(t, viper.GetString("api_key"), "The api_key_command of the context replaces the top-level api_key")
+	assert.Equal(t, "pass show n8n/prod", viper.GetString("api_key_command"))
+	assert.Equal(t, "30s", viper.GetString("timeout"), "Settings missing from the context fall back to the top level")
+	assert.Equal(t, "/etc/ssl/corporate-ca.pem", viper.GetString("ca_file"))
+	assert.Equal(t, "bearer", viper.GetString("auth.type"))
+	assert.Equal(t, "prod-gateway-token", viper.GetString("auth.token"))
+}
+
+func TestContexts_EnvironmentOverridesContext(t *testing.T) {
+	initializeWithConfig(t, contextsConfig, "")
+	t.Setenv("N8N_INSTANCE_URL", "http://from-env:5678")
+
+	assert.Equal(t, "http://from-env:5678", viper.GetString("instance_url"))
+	assert.Equal(t, "dev-key", viper.GetString("api_key"))
+}
+
+func TestContexts_KeyCommandReplacesTopLevelKey(t *testing.T) {
+	initializeWithConfig(t, `
+api_key: top-level-key
+contexts:
+  prod:
+    api_key_command: echo prod-key
+`, "prod")
+
+	apiKey, err := config.ResolveAPIKey(context.Background(), viper.GetViper())
+	require.NoError(t, err)
+	assert.Equal(t, "prod-key", apiKey)
+}
+
+func TestContexts_KeyReplacesTopLevelKeyCommand(t *testing.T) {
+	initializeWithConfig(t, `
+api_key_command: echo top-level-key
+contexts:
+  prod:
+    api_key: prod-key
+`, "prod")
+
+	assert.Empty(t, viper.GetString("api_key_command"))
+	assert.Equal(t, "prod-key", viper.GetString("api_key"))
+}
+
+func TestContexts_EnvFilePrecedence(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile(".env", []byte("N8N_INSTANCE_URL=http://from-env-file:5678\nN8N_API_KEY=env-file-key\n"), 0600))
+
+	t.Run("Overrides the current context", func(t *testing.T) {
+		initializeWithConfig(t, contextsConfig, "")
+
+		assert.Equal(t, "http://from-env-file:5678", viper.GetString("instance_url"))
+		assert.Equal(t, "env-file-key", viper.GetString("api_key"))
+	})
+
+	t.Run("Does not override a selected context", func(t *testing.T) {
+		initializeWithConfig(t, contextsConfig, "dev")
+
+		assert.Equal(t, "http://dev:5678", viper.GetString("instance_url"))
+		assert.Equal(t, "dev-key", viper.GetString("api_key"))
+	})
+
+	t.Run("Selected context replaces its key", func(t *testing.T) {
+		initializeWithConfig(t, contextsConfig, "prod")
+
+		assert.Equal(t, "https://prod.example.com", viper.GetString("instance_url"))
+		assert.Empty(t, viper.GetString("api_key"))
+		assert.Equal(t, "pass show n8n/prod", viper.GetString("api_key_command"))
+	})
+}
+
+func TestContexts_UnknownContext(t *testing.T) {
+	initializeWithConfig(t, contextsConfig, "")
+	viper.Set(config.ContextKey, "staging")
+
+	err := config.ValidateContext(viper.GetViper())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `context "staging" not found`)
+	assert.Contains(t, err.Error(), "[dev prod]")
+}
+
+func TestContexts_WithoutContexts(t *testing.T) {
+	initializeWithConfig(t, "instance_url: http://single:5678\n", "")
+
+	assert.Empty(t, config.ActiveContext(viper.GetViper()))
+	assert.Empty(t, config.ContextNames(viper.GetViper()))
+	assert.NoError(t, config.ValidateContext(viper.GetViper()))
+	assert.Equal(t, "http://single:5678", viper.GetString("instance_url"))
+}
+
+func TestFile_SetPreservesComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("# my instances\ncurrent-context: dev # default\ncontexts:\n  dev:\n    instance_url: http://dev:5678\n"), 0600))
+
+	file, err := config.LoadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, file.Set([]string{"current-context"}, "prod"))
+	require.NoError(t, file.Set([]string{"contexts", "prod", "instance_url"}, "https://prod.example.com"))
+	require.NoError(t, file.Save())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# my instances")
+	assert.Contains(t, string(content), "current-context: prod")
+	assert.Contains(t, string(content), "instance_url: http://dev:5678")
+	assert.Contains(t, string(content), "  prod:\n    instance_url: https://prod.example.com")
+
+	err = file.Set([]string{"current-context", "nested"}, "value")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "current-context is not a mapping")
+}
+
+func TestLoadFile_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.yaml")
+
+	file, err := config.LoadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, file.Set([]string{"current-context"}, "dev"))
+	require.NoError(t, file.Save())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "current-context: dev\n", string(content))
+}
@@ -0,0 +1,167 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// opensslEncrypted was produced by password_helper-aes.sh with ENC_KEY="correct horse":
+// echo -n "s3cr3t-api-key" | openssl enc -aes-256-cbc -pbkdf2 -iter 100000 -salt -base64 -A -pass pass:"correct horse"
+const opensslEncrypted = "ENC(U2FsdGVkX1/CY7bK0pTJDOY6kVYVBCd+x5HBxckmbB0=)"
+
+// stubPassphrasePrompt replaces the passphrase prompt for the duration of the test
+func stubPassphrasePrompt(t *testing.T, answers ...string) *int {
+	calls := 0
+	original := config.PassphrasePrompt
+	config.PassphrasePrompt = func(prompt string) (string, error) {
+		if calls >= len(answers) {
+			return "", fmt.Errorf("unexpected prompt %q", prompt)
+		}
+		calls++
+		return answers[calls-1], nil
+	}
+	t.Cleanup(func() { config.PassphrasePrompt = original })
+
+	return &calls
+}
+
+func TestDecryptValue_OpenSSLCompatible(t *testing.T) {
+	plaintext, err := config.DecryptValue(opensslEncrypted, "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t-api-key", plaintext)
+}
+
+func TestEncryptValue_RoundTrip(t *testing.T) {
+	for _, plaintext := range []string{"a", "exactly-16-bytes", "n8n_api_0123456789abcdef0123456789abcdef", "ünïcödé"} {
+		encrypted, err := config.EncryptValue(plaintext, "passphrase")
+		require.NoError(t, err)
+		assert.True(t, config.IsEncrypted(encrypted))
+
+		decrypted, err := config.DecryptValue(encrypted, "passphrase")
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	}
+}
+
+func TestDecryptValue_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		passphrase string
+		wantErr    string
+	}{
+		{name: "Wrong passphrase", value: opensslEncrypted, passphrase: "wrong", wantErr: "decryption failed"},
+		{name: "Not wrapped", value: "plain", passphrase: "correct horse", wantErr: "not in ENC(...) format"},
+		{name: "Invalid base64", value: "ENC(not base64!)", passphrase: "correct horse", wantErr: "invalid base64"},
+		{name: "Missing salt header", value: "ENC(YWJjZGVmZ2hpamtsbW5vcA==)", passphrase: "correct horse", wantErr: "no salt header"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := config.DecryptValue(tt.value, tt.passphrase)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadEnvFile_KeepsEncryptedValues(t *testing.T) {
+	t.Setenv(config.PassphraseEnvVar, "")
+	t.Setenv("N8N_API_KEY", "")
+	calls := stubPassphrasePrompt(t)
+
+	v := viper.New()
+	config.LoadEnvFileWithReader(&envFileReader{content: "N8N_API_KEY=" + opensslEncrypted + "\n"}, v)
+
+	assert.Equal(t, opensslEncrypted, v.GetString("api_key"))
+	assert.Equal(t, 0, *calls, "Loading the configuration must not ask for the passphrase")
+}
+
+func TestDecryptSettings(t *testing.T) {
+	envFile := &envFileReader{content: "N8N_API_KEY=" + opensslEncrypted + "\nN8N_INSTANCE_URL=http://plain:5678\n"}
+
+	t.Run("Passphrase from environment", func(t *testing.T) {
+		t.Setenv(config.PassphraseEnvVar, "correct horse")
+		t.Setenv("N8N_API_KEY", "")
+		stubPassphrasePrompt(t)
+
+		v := viper.New()
+		config.LoadEnvFileWithReader(envFile, v)
+		require.NoError(t, config.DecryptSettings(v))
+
+		assert.Equal(t, "s3cr3t-api-key", v.GetString("api_key"))
+		assert.Equal(t, "http://plain:5678", v.GetString("instance_url"))
+	})
+
+	t.Run("Passphrase from key file", func(t *testing.T) {
+		t.Setenv(config.PassphraseEnvVar, "")
+		t.Setenv("N8N_API_KEY", "")
+		stubPassphrasePrompt(t)
+		keyFile := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyFile, []byte("correct horse\n"), 0600))
+
+		v := viper.New()
+		v.Set("enc_key_file", keyFile)
+		config.LoadEnvFileWithReader(envFile, v)
+		require.NoError(t, config.DecryptSettings(v))
+
+		assert.Equal(t, "s3cr3t-api-key", v.GetString("api_key"))
+	})
+
+	t.Run("Passphrase prompted once", func(t *testing.T) {
+		t.Setenv(config.PassphraseEnvVar, "")
+		t.Setenv("N8N_API_KEY", "")
+		calls := stubPassphrasePrompt(t, "correct horse")
+
+		v := viper.New()
+		config.LoadEnvFileWithReader(&envFileReader{content: envFile.content + "N8N_OTHER=" + opensslEncrypted + "\n"}, v)
+		require.NoError(t, config.DecryptSettings(v))
+		require.NoError(t, config.DecryptSettings(v))
+
+		assert.Equal(t, "s3cr3t-api-key", v.GetString("api_key"))
+		assert.Equal(t, "s3cr3t-api-key", v.GetString("other"))
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("Wrong passphrase", func(t *testing.T) {
+		t.Setenv(config.PassphraseEnvVar, "wrong")
+		t.Setenv("N8N_API_KEY", "")
+
+		v := viper.New()
+		config.LoadEnvFileWithReader(envFile, v)
+		err := config.DecryptSettings(v)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not decrypt api_key")
+		assert.NotContains(t, err.Error(), "s3cr3t-api-key")
+	})
+
+	t.Run("Resolving the API key decrypts", func(t *testing.T) {
+		t.Setenv(config.PassphraseEnvVar, "correct horse")
+		t.Setenv("N8N_API_KEY", "")
+
+		v := viper.New()
+		config.LoadEnvFileWithReader(envFile, v)
+		apiKey, err := config.ResolveAPIKey(context.Background(), v)
+
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t-api-key", apiKey)
+	})
+}
+
+func TestResolvePassphrase_ConfirmMismatch(t *testing.T) {
+	t.Setenv(config.PassphraseEnvVar, "")
+	stubPassphrasePrompt(t, "first", "second")
+
+	_, err := config.ResolvePassphrase(viper.New(), true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "passphrases do not match")
+}
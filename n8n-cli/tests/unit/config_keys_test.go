@@ -0,0 +1,204 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/config"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSettings(t *testing.T) {
+	settings := map[string]interface{}{
+		"instance_url":  "n8n.example.com",
+		"instance-url":  "http://typo:5678",
+		"timeout":       30,
+		"retry_backoff": "1s",
+		"proxy_url":     "socks5://proxy:1080",
+		"auth": map[string]interface{}{
+			"type":    "basic",
+			"headers": map[string]interface{}{"X-Custom": "value"},
+			"tokn":    "typo",
+		},
+		"contexts": map[string]interface{}{
+			"prod": map[string]interface{}{
+				"instance_url":         "ftp://prod",
+				"current-context":      "dev",
+				"insecure_skip_verify": true,
+			},
+			"dev": nil,
+		},
+	}
+
+	var problems []string
+	for _, problem := range config.ValidateSettings(settings) {
+		problems = append(problems, problem.Error())
+	}
+
+	assert.ElementsMatch(t, []string{
+		`auth.tokn: unknown configuration key "auth.tokn"`,
+		`auth.type: auth.type must be header, bearer or exec, got "basic"`,
+		`contexts.prod.current-context: key "current-context" cannot be set inside a context`,
+		`contexts.prod.instance_url: instance_url must use the http, https scheme, got "ftp://prod"`,
+		`instance-url: unknown configuration key "instance-url", did you mean "instance_url"?`,
+		`instance_url: instance_url must be an absolute URL such as https://n8n.example.com, got "n8n.example.com"`,
+		`timeout: timeout must be a duration such as 30s or 1m, got 30`,
+	}, problems)
+}
+
+func TestRedact(t *testing.T) {
+	settings := map[string]interface{}{
+		"instance_url": "http://localhost:5678",
+		"api_key":      "s3cr3t",
+		"auth": map[string]interface{}{
+			"type":    "header",
+			"token":   "gateway-token",
+			"headers": map[string]interface{}{"cf-access-client-secret": "secret"},
+		},
+		"contexts": map[string]interface{}{
+			"prod": map[string]interface{}{"api_key": "prod-key", "api_key_command": "pass show n8n"},
+		},
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"instance_url": "http://localhost:5678",
+		"api_key":      config.RedactedValue,
+		"auth": map[string]interface{}{
+			"type":    "header",
+			"token":   config.RedactedValue,
+			"headers": map[string]interface{}{"cf-access-client-secret": config.RedactedValue},
+		},
+		"contexts": map[string]interface{}{
+			"prod": map[string]interface{}{"api_key": config.RedactedValue, "api_key_command": "pass show n8n"},
+		},
+	}, config.Redact("", settings))
+	assert.Equal(t, "", config.Redact("api_key", ""), "Empty secrets are shown as empty")
+}
+
+func TestResolveKeyPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		wantKey  string
+		wantPath []string
+		wantErr  string
+	}{
+		{path: "Instance_URL", wantKey: "instance_url", wantPath: []string{"instance_url"}},
+		{path: "contexts.Prod.auth.token", wantKey: "auth.token", wantPath: []string{"contexts", "prod", "auth", "token"}},
+		{path: "auth.headers.X-Gateway-Key", wantKey: "auth.headers.x-gateway-key", wantPath: []string{"auth", "headers", "X-Gateway-Key"}},
+		{path: "contexts.prod", wantErr: "is a context"},
+		{path: "contexts.prod.contexts", wantErr: "cannot be set inside a context"},
+		{path: "retry-backoff", wantErr: `did you mean "retry_backoff"`},
+		{path: "auth..type", wantErr: "invalid key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			key, path, err := config.ResolveKeyPath(tt.path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKey, key.Name)
+			assert.Equal(t, tt.wantPath, path)
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	key := func(name string) config.Key {
+		k, ok := config.LookupKey(name)
+		require.True(t, ok)
+		return k
+	}
+
+	value, err := config.ParseValue(key("retry_max_attempts"), "5")
+	require.NoError(t, err)
+	assert.Equal(t, 5, value)
+
+	value, err = config.ParseValue(key("insecure_skip_verify"), "true")
+	require.NoError(t, err)
+	assert.Equal(t, true, value)
+
+	value, err = config.ParseValue(key("auth.args"), "auth, print-identity-token")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"auth", "print-identity-token"}, value)
+
+	_, err = config.ParseValue(key("timeout"), "30")
+	assert.ErrorContains(t, err, "must be a duration")
+
+	_, err = config.ParseValue(key("instance_url"), "localhost:5678")
+	assert.ErrorContains(t, err, "must be an absolute URL")
+
+	_, err = config.ParseValue(key("auth"), "bearer")
+	assert.ErrorContains(t, err, "is a section")
+}
+
+func TestFile_Unset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("# instances\ntimeout: 30s\ncontexts:\n  dev:\n    instance_url: http://dev:5678\n    api_key: dev-key\n"), 0600))
+
+	file, err := config.LoadFile(path)
+	require.NoError(t, err)
+	assert.True(t, file.Unset([]string{"contexts", "dev", "api_key"}))
+	assert.True(t, file.Unset([]string{"timeout"}))
+	assert.False(t, file.Unset([]string{"timeout"}))
+	assert.False(t, file.Unset([]string{"contexts", "prod", "api_key"}))
+	require.NoError(t, file.Save())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "# instances\ncontexts:\n  dev:\n    instance_url: http://dev:5678\n", string(content))
+}
+
+func TestExplain_Layers(t *testing.T) {
+	home := t.TempDir()
+	work := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".n8n"), 0700))
+	homeConfig := filepath.Join(home, ".n8n", "config.yaml")
+	require.NoError(t, os.WriteFile(homeConfig, []byte("instance_url: http://home:5678\napi_key: home-key\ncurrent-context: dev\ncontexts:\n  dev:\n    instance_url: http://dev:5678\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(work, "config.yaml"), []byte("instance_url: http://local:5678\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(work, ".env"), []byte("N8N_INSTANCE_URL=http://dotenv:5678\n"), 0600))
+
+	t.Setenv("HOME", home)
+	t.Setenv("N8N_API_KEY", "")
+	t.Setenv("N8N_CONTEXT", "")
+	t.Setenv("N8N_INSTANCE_URL", "http://env:5678")
+	t.Chdir(work)
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	config.Initialize()
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("url", "http://localhost:5678", "")
+	require.NoError(t, flags.Set("url", "http://flag:5678"))
+
+	layers, err := config.Explain(viper.GetViper(), flags, "instance_url")
+	require.NoError(t, err)
+
+	localConfig, err := filepath.Abs("config.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []config.Layer{
+		{Source: config.SourceFlag, Origin: "--url", Value: "http://flag:5678", Status: config.LayerActive},
+		{Source: config.SourceEnv, Origin: "N8N_INSTANCE_URL", Value: "http://env:5678", Status: config.LayerOverridden},
+		{Source: config.SourceEnvFile, Origin: "N8N_INSTANCE_URL", Value: "http://dotenv:5678", Status: config.LayerOverridden},
+		{Source: config.SourceContext, Origin: "dev", Value: "http://dev:5678", Status: config.LayerOverridden},
+		{Source: config.SourceConfigFile, Origin: homeConfig, Value: "http://home:5678", Status: config.LayerOverridden},
+		{Source: config.SourceConfigFile, Origin: localConfig, Value: "http://local:5678", Status: config.LayerNotLoaded},
+		{Source: config.SourceDefault, Origin: "--url", Value: "http://localhost:5678", Status: config.LayerOverridden},
+	}, layers)
+
+	layers, err = config.Explain(viper.GetViper(), flags, "api_key")
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	assert.Equal(t, config.Layer{Source: config.SourceConfigFile, Origin: homeConfig, Value: config.RedactedValue, Status: config.LayerActive}, layers[0])
+
+	_, err = config.Explain(viper.GetViper(), flags, "instance-url")
+	assert.ErrorContains(t, err, `did you mean "instance_url"`)
+}
@@ -0,0 +1,93 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpHeaderSchema mirrors the schema n8n generates for a credential type with a
+// field that is only required for one of the authentication methods
+const httpHeaderSchema = `{
+  "additionalProperties": false,
+  "type": "object",
+  "properties": {
+    "authentication": {"type": "string", "enum": ["header", "oAuth2"]},
+    "name": {"type": "string"},
+    "value": {"type": "string"},
+    "port": {"type": "integer"},
+    "allowedDomains": {"type": "array", "items": {"type": "string"}},
+    "clientId": {"type": "string"}
+  },
+  "required": ["name"],
+  "allOf": [
+    {
+      "if": {"properties": {"authentication": {"enum": ["oAuth2"]}}},
+      "then": {"required": ["clientId"]},
+      "else": {"required": ["value"]}
+    }
+  ]
+}`
+
+func loadSchema(t *testing.T, content string) map[string]interface{} {
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(content), &schema))
+	return schema
+}
+
+func TestValidateCredentialData(t *testing.T) {
+	schema := loadSchema(t, httpHeaderSchema)
+
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{name: "Valid", data: `{"name": "X-Key", "authentication": "header", "value": "secret"}`},
+		{name: "Condition without its field", data: `{"name": "X-Key", "value": "secret"}`, want: []string{"clientId: is required"}},
+		{name: "Valid conditional field", data: `{"name": "X-Key", "authentication": "oAuth2", "clientId": "id"}`},
+		{name: "Missing required field", data: `{"authentication": "header", "value": "secret"}`, want: []string{"name: is required"}},
+		{name: "Missing conditional field", data: `{"name": "X-Key", "authentication": "oAuth2"}`, want: []string{"clientId: is required"}},
+		{name: "Unknown field", data: `{"name": "X-Key", "authentication": "header", "value": "secret", "Value": "typo"}`, want: []string{`Value: is not a field of this credential type, did you mean "value"?`}},
+		{name: "Invalid value is not printed", data: `{"name": "X-Key", "authentication": "s3cr3t-token", "value": "secret"}`, want: []string{`authentication: must be one of "header", "oAuth2", got a value of type string`}},
+		{
+			name: "Wrong types and enum",
+			data: `{"name": 1, "value": "secret", "port": 80.5, "authentication": "basic", "allowedDomains": ["a", 2]}`,
+			want: []string{
+				"allowedDomains[1]: must be of type string, got number",
+				`authentication: must be one of "header", "oAuth2", got a value of type string`,
+				"name: must be of type string, got number",
+				"port: must be of type integer, got number",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &data))
+
+			var problems []string
+			for _, violation := range n8n.ValidateCredentialData(schema, data) {
+				problems = append(problems, violation.Error())
+			}
+			assert.Equal(t, tt.want, problems)
+		})
+	}
+}
+
+func TestCredentialSchemaProperties(t *testing.T) {
+	properties := n8n.CredentialSchemaProperties(loadSchema(t, httpHeaderSchema))
+
+	names := make([]string, len(properties))
+	for i, property := range properties {
+		names[i] = property.Name
+	}
+	assert.Equal(t, []string{"name", "allowedDomains", "authentication", "clientId", "port", "value"}, names)
+	assert.True(t, properties[0].Required)
+	assert.Equal(t, "integer", properties[4].Type)
+	assert.Equal(t, []interface{}{"header", "oAuth2"}, properties[2].Enum)
+}
@@ -0,0 +1,88 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const executionDataJSON = `{
+  "resultData": {
+    "lastNodeExecuted": "HTTP Request",
+    "runData": {
+      "HTTP Request": [{
+        "startTime": 1000010, "executionTime": 250, "executionStatus": "error",
+        "source": [{"previousNode": "Set", "previousNodeRun": 1}],
+        "error": {"message": "Request failed with status code 500", "description": "Bad gateway", "stack": "NodeApiError: Request failed"}
+      }],
+      "Set": [
+        {"startTime": 1000005, "executionTime": 1, "executionStatus": "success", "source": [{"previousNode": "Webhook"}], "data": {"main": [[{"json": {"n": 1}}]]}},
+        {"startTime": 1000008, "executionTime": 2, "executionStatus": "success", "source": [{"previousNode": "Webhook"}], "data": {"main": [[{"json": {"n": 1}}, {"json": {"n": 2}}]]}}
+      ],
+      "Webhook": [{
+        "startTime": 1000000, "executionTime": 3, "executionStatus": "success", "source": [],
+        "data": {"main": [[{"json": {"a": 1}}, {"json": {"a": 2}}, {"json": {"a": 3}}]]}
+      }]
+    }
+  }
+}`
+
+func TestExecutionNodeRuns(t *testing.T) {
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(executionDataJSON), &data))
+
+	runs, lastNode := n8n.ExecutionNodeRuns(n8n.Execution{Data: &data})
+	assert.Equal(t, "HTTP Request", lastNode)
+	require.Len(t, runs, 4)
+
+	type summary struct {
+		Node     string
+		RunIndex int
+		ItemsIn  int
+		ItemsOut int
+		Status   string
+	}
+	var got []summary
+	for _, run := range runs {
+		got = append(got, summary{run.Node, run.RunIndex, run.ItemsIn, run.ItemsOut, run.Status})
+	}
+	assert.Equal(t, []summary{
+		{"Webhook", 0, 0, 3, "success"},
+		{"Set", 0, 3, 1, "success"},
+		{"Set", 1, 3, 2, "success"},
+		{"HTTP Request", 0, 2, 0, "error"},
+	}, got)
+
+	assert.Equal(t, time.UnixMilli(1000000), runs[0].StartTime)
+	assert.Equal(t, 250*time.Millisecond, runs[3].ExecutionTime)
+	assert.Equal(t, &n8n.NodeRunError{Message: "Request failed with status code 500", Description: "Bad gateway", Stack: "NodeApiError: Request failed"}, runs[3].Error)
+	assert.Nil(t, runs[3].Output)
+}
+
+func TestExecutionNodeRuns_WithoutData(t *testing.T) {
+	runs, lastNode := n8n.ExecutionNodeRuns(n8n.Execution{})
+	assert.Empty(t, runs)
+	assert.Empty(t, lastNode)
+}
+
+func TestExecutionNodeRuns_NegativeSourceIndexes(t *testing.T) {
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "resultData": {
+    "runData": {
+      "Webhook": [{"startTime": 1000000, "source": [], "data": {"main": [[{"json": {"a": 1}}]]}}],
+      "Set": [{"startTime": 1000001, "source": [{"previousNode": "Webhook", "previousNodeRun": -1}]}],
+      "Merge": [{"startTime": 1000002, "source": [{"previousNode": "Webhook", "previousNodeOutput": -1}]}]
+    }
+  }
+}`), &data))
+
+	runs, _ := n8n.ExecutionNodeRuns(n8n.Execution{Data: &data})
+	require.Len(t, runs, 3)
+	assert.Equal(t, 0, runs[1].ItemsIn)
+	assert.Equal(t, 0, runs[2].ItemsIn)
+}
@@ -3,6 +3,7 @@ package unit
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -73,7 +74,7 @@ func TestActivateCommandExecute(t *testing.T) {
 				}
 
 				workflowID := args[0]
-				workflow, err := fakeClient.ActivateWorkflow(workflowID)
+				workflow, err := fakeClient.ActivateWorkflow(context.Background(), workflowID)
 
 				if err != nil {
 					cmd.PrintErrf("Error activating workflow: %v\n", err)
@@ -102,7 +103,7 @@ func TestActivateCommandExecute(t *testing.T) {
 			assert.Equal(t, tc.expectedOutput, output)
 
 			assert.Equal(t, 1, fakeClient.ActivateWorkflowCallCount())
-			passedWorkflowID := fakeClient.ActivateWorkflowArgsForCall(0)
+			_, passedWorkflowID := fakeClient.ActivateWorkflowArgsForCall(0)
 			assert.Equal(t, tc.workflowID, passedWorkflowID)
 		})
 	}
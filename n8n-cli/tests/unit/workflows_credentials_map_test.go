@@ -0,0 +1,135 @@
+package unit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edenreich/n8n-cli/cmd/workflows"
+	"github.com/edenreich/n8n-cli/n8n"
+	"github.com/edenreich/n8n-cli/n8n/clientfakes"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const credentialsMapYAML = `credentials:
+  slackApi:
+    Slack Alerts: 42
+  postgres:
+    Production DB: "7"
+`
+
+// newCredentialsMapCommand returns a command with --credentials-map set to a map file
+func newCredentialsMapCommand(t *testing.T) (*cobra.Command, *bytes.Buffer) {
+	mapFile := filepath.Join(t.TempDir(), "credentials.map.yaml")
+	require.NoError(t, os.WriteFile(mapFile, []byte(credentialsMapYAML), 0644))
+
+	stderr := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(stderr)
+	cmd.Flags().String("credentials-map", "", "")
+	require.NoError(t, cmd.Flags().Set("credentials-map", mapFile))
+	return cmd, stderr
+}
+
+func TestProcessWorkflowFile_ResolvesMappedCredentials(t *testing.T) {
+	fakeClient := &clientfakes.FakeClientInterface{}
+	fakeClient.CreateWorkflowReturns(&n8n.Workflow{Id: stringPtr("1"), Name: "Alerts"}, nil)
+	cmd, stderr := newCredentialsMapCommand(t)
+
+	workflowFile := filepath.Join(t.TempDir(), "alerts.json")
+	require.NoError(t, os.WriteFile(workflowFile, []byte(`{
+	  "name": "Alerts",
+	  "nodes": [
+	    {"name": "Slack", "type": "n8n-nodes-base.slack", "credentials": {"slackApi": {"name": "Slack Alerts"}}},
+	    {"name": "Postgres", "type": "n8n-nodes-base.postgres", "credentials": {"postgres": {"id": "dev-3", "name": "Production DB"}}},
+	    {"name": "HTTP Request", "type": "n8n-nodes-base.httpRequest", "credentials": {"httpBasicAuth": {"name": "Partner API"}}}
+	  ],
+	  "connections": {}
+	}`), 0644))
+
+	_, err := workflows.ProcessWorkflowFile(fakeClient, cmd, workflowFile, false, false)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, fakeClient.CreateWorkflowCallCount())
+	_, created := fakeClient.CreateWorkflowArgsForCall(0)
+	credentials := make(map[string]interface{})
+	for _, node := range created.Nodes {
+		for credentialType, credential := range *node.Credentials {
+			credentials[credentialType] = credential
+		}
+	}
+	assert.Equal(t, map[string]interface{}{
+		"slackApi":      map[string]interface{}{"id": "42", "name": "Slack Alerts"},
+		"postgres":      map[string]interface{}{"id": "7", "name": "Production DB"},
+		"httpBasicAuth": map[string]interface{}{"name": "Partner API"},
+	}, credentials)
+	assert.Contains(t, stderr.String(), "Warning: credential 'Partner API' (httpBasicAuth) of node 'HTTP Request' is not in the credentials map in alerts.json")
+}
+
+func TestRefreshWorkflowsWithClient_NeutralizesMappedCredentials(t *testing.T) {
+	directory := t.TempDir()
+	fakeClient := &clientfakes.FakeClientInterface{}
+	remoteCredentials := map[string]interface{}{
+		"slackApi":      map[string]interface{}{"id": "42", "name": "Slack (production)"},
+		"httpBasicAuth": map[string]interface{}{"id": "99", "name": "Partner API"},
+	}
+	fakeClient.GetWorkflowsReturns(&n8n.WorkflowList{Data: &[]n8n.Workflow{{
+		Id:          stringPtr("1"),
+		Name:        "Alerts",
+		Nodes:       []n8n.Node{{Name: stringPtr("Slack"), Type: stringPtr("n8n-nodes-base.slack"), Credentials: &remoteCredentials}},
+		Connections: map[string]interface{}{},
+	}}}, nil)
+	cmd, _ := newCredentialsMapCommand(t)
+
+	err := workflows.RefreshWorkflowsWithClient(cmd, fakeClient, directory, false, false, "json", true, true)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(directory, "Alerts.json"))
+	require.NoError(t, err)
+	var written map[string]interface{}
+	require.NoError(t, json.Unmarshal(content, &written))
+	node := written["nodes"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"slackApi":      map[string]interface{}{"name": "Slack Alerts"},
+		"httpBasicAuth": map[string]interface{}{"id": "99", "name": "Partner API"},
+	}, node["credentials"])
+	assert.Equal(t, "42", remoteCredentials["slackApi"].(map[string]interface{})["id"], "The fetched workflow is not changed")
+}
+
+func TestCredentialsMap_MalformedFileFailsBeforeAnyWorkflow(t *testing.T) {
+	mapFile := filepath.Join(t.TempDir(), "credentials.map.yaml")
+	require.NoError(t, os.WriteFile(mapFile, []byte("credentials: [slackApi\n"), 0644))
+	directory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "alerts.json"), []byte(`{"name": "Alerts", "nodes": [], "connections": {}}`), 0644))
+
+	newCommand := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.Flags().String("directory", "", "")
+		cmd.Flags().String("credentials-map", "", "")
+		require.NoError(t, cmd.Flags().Set("directory", directory))
+		require.NoError(t, cmd.Flags().Set("credentials-map", mapFile))
+		return cmd
+	}
+
+	t.Run("Sync", func(t *testing.T) {
+		err := workflows.SyncWorkflows(newCommand(), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error parsing credentials map")
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		fakeClient := &clientfakes.FakeClientInterface{}
+
+		err := workflows.RefreshWorkflowsWithClient(newCommand(), fakeClient, directory, false, false, "json", true, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error parsing credentials map")
+		assert.Equal(t, 0, fakeClient.GetWorkflowsCallCount())
+	})
+}
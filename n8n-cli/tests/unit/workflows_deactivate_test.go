@@ -3,6 +3,7 @@ package unit
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -73,7 +74,7 @@ func TestDeactivateCommandExecute(t *testing.T) {
 				}
 
 				workflowID := args[0]
-				workflow, err := fakeClient.DeactivateWorkflow(workflowID)
+				workflow, err := fakeClient.DeactivateWorkflow(context.Background(), workflowID)
 
 				if err != nil {
 					cmd.PrintErrf("Error deactivating workflow: %v\n", err)
@@ -102,7 +103,7 @@ func TestDeactivateCommandExecute(t *testing.T) {
 			assert.Equal(t, tc.expectedOutput, output)
 
 			assert.Equal(t, 1, fakeClient.DeactivateWorkflowCallCount())
-			id := fakeClient.DeactivateWorkflowArgsForCall(0)
+			_, id := fakeClient.DeactivateWorkflowArgsForCall(0)
 			assert.Equal(t, tc.workflowID, id)
 		})
 	}
@@ -3,6 +3,7 @@ package unit
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -60,7 +61,7 @@ func TestDeleteCommandExecute(t *testing.T) {
 				}
 
 				workflowID := args[0]
-				err := fakeClient.DeleteWorkflow(workflowID)
+				err := fakeClient.DeleteWorkflow(context.Background(), workflowID)
 
 				if err != nil {
 					cmd.PrintErrf("Error deleting workflow: %v\n", err)
@@ -86,7 +87,7 @@ func TestDeleteCommandExecute(t *testing.T) {
 			assert.Equal(t, tc.expectedOutput, output)
 
 			assert.Equal(t, 1, fakeClient.DeleteWorkflowCallCount())
-			passedWorkflowID := fakeClient.DeleteWorkflowArgsForCall(0)
+			_, passedWorkflowID := fakeClient.DeleteWorkflowArgsForCall(0)
 			assert.Equal(t, tc.workflowID, passedWorkflowID)
 		})
 	}
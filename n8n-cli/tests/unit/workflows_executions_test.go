@@ -83,7 +83,7 @@ func TestExecutionsCommand(t *testing.T) {
 		assert.Contains(t, stdout.String(), "Execution history")
 		assert.Contains(t, stdout.String(), "next-page-cursor")
 
-		_, includeData, status, limit, cursor := fakeClient.GetExecutionsArgsForCall(0)
+		_, _, includeData, status, limit, cursor := fakeClient.GetExecutionsArgsForCall(0)
 		assert.False(t, includeData)
 		assert.Empty(t, status)
 		assert.Equal(t, 10, limit)
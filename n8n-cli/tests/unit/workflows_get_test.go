@@ -2,6 +2,7 @@
 package unit
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -39,10 +40,10 @@ func TestGetWorkflow(t *testing.T) {
 			fakeClient := &clientfakes.FakeClientInterface{}
 			fakeClient.GetWorkflowReturns(tc.mockReturnWF, tc.mockReturnErr)
 
-			workflow, err := fakeClient.GetWorkflow(tc.workflowID)
+			workflow, err := fakeClient.GetWorkflow(context.Background(), tc.workflowID)
 
 			// Check that the correct workflow ID was passed
-			id := fakeClient.GetWorkflowArgsForCall(0)
+			_, id := fakeClient.GetWorkflowArgsForCall(0)
 			assert.Equal(t, tc.workflowID, id)
 
 			if tc.expectError {
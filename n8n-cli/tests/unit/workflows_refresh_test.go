@@ -3,6 +3,7 @@ package unit
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"os"
@@ -406,7 +407,7 @@ originalName: "Test Workflow 3"
 			fakeClient := &clientfakes.FakeClientInterface{}
 			fakeClient.GetWorkflowsReturns(tc.mockResponses, tc.mockError)
 
-			fakeClient.GetWorkflowCalls(func(id string) (*n8n.Workflow, error) {
+			fakeClient.GetWorkflowCalls(func(_ context.Context, id string) (*n8n.Workflow, error) {
 				if tc.name == "Handles errors when fetching individual workflows" {
 					switch id {
 					case "success456":
@@ -1,7 +1,9 @@
 package unit
 
 import (
-	"fmt"
+	"bytes"
+	"context"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
@@ -35,7 +37,7 @@ func TestProcessWorkflowFile_ReturnsWorkflowResult(t *testing.T) {
 		Id:   &workflowID,
 	}
 
-	fakeClient.GetWorkflowReturns(nil, fmt.Errorf("workflow not found"))
+	fakeClient.GetWorkflowReturns(nil, &n8n.APIError{StatusCode: http.StatusNotFound, Message: "Workflow not found"})
 
 	newID := "new-id-456"
 	fakeClient.CreateWorkflowReturns(&n8n.Workflow{
@@ -57,6 +59,22 @@ func TestProcessWorkflowFile_ReturnsWorkflowResult(t *testing.T) {
 	assert.False(t, result.Updated)
 }
 
+func TestProcessWorkflowFile_AbortsWhenServerFails(t *testing.T) {
+	fakeClient := &clientfakes.FakeClientInterface{}
+	cmd := &cobra.Command{}
+
+	testFilePath := filepath.Join(t.TempDir(), "test-workflow.json")
+	err := os.WriteFile(testFilePath, []byte(`{"name": "Test Workflow", "id": "test-id-123"}`), 0644)
+	require.NoError(t, err)
+
+	fakeClient.GetWorkflowReturns(nil, &n8n.APIError{StatusCode: http.StatusServiceUnavailable, Message: "Service unavailable"})
+
+	_, err = workflows.ProcessWorkflowFile(fakeClient, cmd, testFilePath, false, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error fetching workflow test-id-123")
+	assert.Equal(t, 0, fakeClient.CreateWorkflowCallCount(), "A workflow must not be created when the server is failing")
+}
+
 func TestWorkflowResult(t *testing.T) {
 	result := workflows.WorkflowResult{
 		WorkflowID: "123",
@@ -72,3 +90,32 @@ func TestWorkflowResult(t *testing.T) {
 	assert.True(t, result.Created)
 	assert.False(t, result.Updated)
 }
+
+func TestRefreshWorkflowsWithClient_StopsWhenContextCancelled(t *testing.T) {
+	tempDir := t.TempDir()
+	fakeClient := &clientfakes.FakeClientInterface{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fakeClient.GetWorkflowsStub = func(context.Context) (*n8n.WorkflowList, error) {
+		cancel()
+		return &n8n.WorkflowList{
+			Data: &[]n8n.Workflow{
+				{Id: stringPtr("1"), Name: "First Workflow"},
+			},
+		}, nil
+	}
+
+	out := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(out)
+	cmd.SetContext(ctx)
+
+	err := workflows.RefreshWorkflowsWithClient(cmd, fakeClient, tempDir, false, false, "json", true, true)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, out.String(), "Interrupted: 0 workflow(s) refreshed before cancellation")
+
+	files, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "No workflow files should be written after cancellation")
+}
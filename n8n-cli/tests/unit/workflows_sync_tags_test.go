@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/edenreich/n8n-cli/cmd/workflows"
@@ -65,7 +66,7 @@ func TestDetectWorkflowChanges_Tags(t *testing.T) {
 			wantTags: false,
 		},
 		{
-			name: "Local has no tags, remote does",
+			name: "Local has an empty tags list, remote has tags",
 			local: &n8n.Workflow{
 				Name: "Test Workflow",
 				Tags: &n8n.WorkflowTags{},
@@ -76,6 +77,48 @@ func TestDetectWorkflowChanges_Tags(t *testing.T) {
 					{Id: stringPtr("1"), Name: "tag1"},
 				},
 			},
+			wantTags: true,
+		},
+		{
+			name: "Local has no tags key, remote has tags",
+			local: &n8n.Workflow{
+				Name: "Test Workflow",
+			},
+			remote: &n8n.Workflow{
+				Name: "Test Workflow",
+				Tags: &n8n.WorkflowTags{
+					{Id: stringPtr("1"), Name: "tag1"},
+				},
+			},
+			wantTags: false,
+		},
+		{
+			name: "Same tag names in another order and without IDs",
+			local: &n8n.Workflow{
+				Name: "Test Workflow",
+				Tags: &n8n.WorkflowTags{
+					{Name: "tag2"},
+					{Name: "tag1"},
+				},
+			},
+			remote: &n8n.Workflow{
+				Name: "Test Workflow",
+				Tags: &n8n.WorkflowTags{
+					{Id: stringPtr("1"), Name: "tag1"},
+					{Id: stringPtr("2"), Name: "tag2"},
+				},
+			},
+			wantTags: false,
+		},
+		{
+			name: "Both have an empty tags list",
+			local: &n8n.Workflow{
+				Name: "Test Workflow",
+				Tags: &n8n.WorkflowTags{},
+			},
+			remote: &n8n.Workflow{
+				Name: "Test Workflow",
+			},
 			wantTags: false,
 		},
 	}
@@ -102,15 +145,75 @@ func TestHandleTagUpdates(t *testing.T) {
 
 	cmd := &cobra.Command{}
 
+	fakeClient.GetTagsReturns(&n8n.TagList{Data: &[]n8n.Tag{
+		{Id: stringPtr("1"), Name: "tag1"},
+		{Id: stringPtr("2"), Name: "tag2"},
+	}}, nil)
 	fakeClient.UpdateWorkflowTagsReturns(n8n.WorkflowTags{}, nil)
 
 	err := workflows.HandleTagUpdates(fakeClient, cmd, workflow, *workflow.Id, false)
 	assert.NoError(t, err)
 
 	assert.Equal(t, 1, fakeClient.UpdateWorkflowTagsCallCount())
-	id, tags := fakeClient.UpdateWorkflowTagsArgsForCall(0)
+	_, id, tags := fakeClient.UpdateWorkflowTagsArgsForCall(0)
 	assert.Equal(t, "123", id)
 	assert.Len(t, tags, 2)
 	assert.Equal(t, "1", tags[0].Id)
 	assert.Equal(t, "2", tags[1].Id)
 }
+
+func TestHandleTagUpdates_ByName(t *testing.T) {
+	fakeClient := &clientfakes.FakeClientInterface{}
+	fakeClient.GetTagsReturns(&n8n.TagList{Data: &[]n8n.Tag{
+		{Id: stringPtr("1"), Name: "keep"},
+		{Id: stringPtr("2"), Name: "stale"},
+	}}, nil)
+	fakeClient.GetWorkflowTagsReturns(n8n.WorkflowTags{
+		{Id: stringPtr("1"), Name: "keep"},
+		{Id: stringPtr("2"), Name: "stale"},
+	}, nil)
+	fakeClient.CreateTagReturns(&n8n.Tag{Id: stringPtr("3"), Name: "new"}, nil)
+
+	workflow := &n8n.Workflow{
+		Name: "Test Workflow",
+		Tags: &n8n.WorkflowTags{
+			{Id: stringPtr("other-instance"), Name: "keep"},
+			{Name: "new"},
+			{Name: "keep"},
+		},
+	}
+
+	out := &strings.Builder{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(out)
+
+	err := workflows.HandleTagUpdates(fakeClient, cmd, workflow, "123", false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fakeClient.CreateTagCallCount())
+	_, name := fakeClient.CreateTagArgsForCall(0)
+	assert.Equal(t, "new", name)
+
+	_, _, tags := fakeClient.UpdateWorkflowTagsArgsForCall(0)
+	assert.Len(t, tags, 2)
+	assert.Equal(t, "1", tags[0].Id, "Local tags are matched by name, not by ID")
+	assert.Equal(t, "3", tags[1].Id)
+	assert.Contains(t, out.String(), "Updated tags for workflow 'Test Workflow' (ID: 123), detaching 'stale'")
+}
+
+func TestHandleTagUpdates_EmptyAndMissingTags(t *testing.T) {
+	fakeClient := &clientfakes.FakeClientInterface{}
+	fakeClient.GetWorkflowTagsReturns(n8n.WorkflowTags{{Id: stringPtr("1"), Name: "tag1"}}, nil)
+	cmd := &cobra.Command{}
+
+	err := workflows.HandleTagUpdates(fakeClient, cmd, &n8n.Workflow{Name: "Test Workflow"}, "123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fakeClient.UpdateWorkflowTagsCallCount(), "A missing tags key leaves the remote tags alone")
+
+	err = workflows.HandleTagUpdates(fakeClient, cmd, &n8n.Workflow{Name: "Test Workflow", Tags: &n8n.WorkflowTags{}}, "123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fakeClient.UpdateWorkflowTagsCallCount())
+	_, _, tags := fakeClient.UpdateWorkflowTagsArgsForCall(0)
+	assert.NotNil(t, tags, "An empty list is sent as [] rather than null")
+	assert.Empty(t, tags)
+}